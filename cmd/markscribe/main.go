@@ -12,7 +12,8 @@ import (
 )
 
 var (
-	write = flag.String("write", "", "write output to")
+	write     = flag.String("write", "", "write output to")
+	githubURL = flag.String("github-url", "", "GitHub Enterprise GraphQL endpoint (overrides GITHUB_GRAPHQL_URL)")
 )
 
 func main() {
@@ -21,8 +22,9 @@ func main() {
 	// Support placing -write after the template argument by scanning remaining args.
 	args := flag.Args()
 	var (
-		templatePath  string
-		writeOverride string
+		templatePath      string
+		writeOverride     string
+		githubURLOverride string
 	)
 	for i := 0; i < len(args); i++ {
 		a := args[i]
@@ -38,6 +40,17 @@ func main() {
 			writeOverride = strings.TrimPrefix(a, "-write=")
 		case strings.HasPrefix(a, "--write="):
 			writeOverride = strings.TrimPrefix(a, "--write=")
+		case a == "-github-url" || a == "--github-url":
+			if i+1 >= len(args) {
+				fmt.Println("Missing value for -github-url")
+				os.Exit(1)
+			}
+			githubURLOverride = args[i+1]
+			i++ // consume value
+		case strings.HasPrefix(a, "-github-url="):
+			githubURLOverride = strings.TrimPrefix(a, "-github-url=")
+		case strings.HasPrefix(a, "--github-url="):
+			githubURLOverride = strings.TrimPrefix(a, "--github-url=")
 		default:
 			if templatePath == "" {
 				templatePath = a
@@ -46,7 +59,7 @@ func main() {
 	}
 
 	if templatePath == "" {
-		fmt.Println("Usage: markscribe [template] [-write output]\nExamples:\n  markscribe README.md.tpl\n  markscribe README.md.tpl -write README.md")
+		fmt.Println("Usage: markscribe [template] [-write output] [-github-url url]\nExamples:\n  markscribe README.md.tpl\n  markscribe README.md.tpl -write README.md")
 		os.Exit(1)
 	}
 
@@ -54,6 +67,9 @@ func main() {
 		// allow override only if not already set via flags
 		*write = writeOverride
 	}
+	if githubURLOverride != "" && *githubURL == "" {
+		*githubURL = githubURLOverride
+	}
 
 	tplIn, err := os.ReadFile(templatePath)
 	if err != nil {
@@ -62,7 +78,11 @@ func main() {
 	}
 
 	// Build template service from environment to keep startup lean
-	tplSvc, err := templatesvc.NewFromEnv(context.Background())
+	cfg := templatesvc.ConfigFromEnv()
+	if *githubURL != "" {
+		cfg.GitHubURL = *githubURL
+	}
+	tplSvc, err := templatesvc.NewFromConfig(context.Background(), cfg)
 	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
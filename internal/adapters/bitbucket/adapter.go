@@ -0,0 +1,307 @@
+package bitbucketadapter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+// DefaultURL is the API root of Bitbucket Cloud.
+const DefaultURL = "https://api.bitbucket.org/2.0"
+
+// maxPageSize is the largest page Bitbucket Cloud serves for most lists.
+const maxPageSize = 100
+
+// Adapter implements ports.BitbucketPort using the Bitbucket Cloud REST API 2.0.
+type Adapter struct {
+	client  *http.Client
+	baseURL string
+
+	mu    sync.Mutex
+	repos map[string]domain.Repo
+}
+
+// New returns an adapter for the API at baseURL (DefaultURL if empty). The
+// client is expected to authenticate its requests; a nil client means
+// http.DefaultClient.
+func New(client *http.Client, baseURL string) *Adapter {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if baseURL == "" {
+		baseURL = DefaultURL
+	}
+	return &Adapter{
+		client:  client,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		repos:   map[string]domain.Repo{},
+	}
+}
+
+// REST lightweight types local to the adapter
+type bbLinks struct {
+	HTML struct {
+		Href string `json:"href"`
+	} `json:"html"`
+	Avatar struct {
+		Href string `json:"href"`
+	} `json:"avatar"`
+}
+
+type bbRepo struct {
+	FullName    string          `json:"full_name"`
+	Description string          `json:"description"`
+	IsPrivate   bool            `json:"is_private"`
+	CreatedOn   time.Time       `json:"created_on"`
+	UpdatedOn   time.Time       `json:"updated_on"`
+	Parent      json.RawMessage `json:"parent"`
+	Links       bbLinks         `json:"links"`
+}
+
+type bbPullRequest struct {
+	ID          int       `json:"id"`
+	Title       string    `json:"title"`
+	State       string    `json:"state"`
+	CreatedOn   time.Time `json:"created_on"`
+	UpdatedOn   time.Time `json:"updated_on"`
+	Links       bbLinks   `json:"links"`
+	Destination struct {
+		Repository struct {
+			FullName string `json:"full_name"`
+		} `json:"repository"`
+	} `json:"destination"`
+}
+
+type bbWorkspace struct {
+	Slug  string  `json:"slug"`
+	Name  string  `json:"name"`
+	Links bbLinks `json:"links"`
+}
+
+// paginate follows the "next" links of a paged list until count items have
+// been collected or the list is exhausted. Items for which keep returns false
+// are skipped; an error from keep aborts paging.
+func paginate[T any](ctx context.Context, a *Adapter, path string, query url.Values, count int, keep func(T) (bool, error)) ([]T, error) {
+	q := url.Values{}
+	for k, v := range query {
+		q[k] = v
+	}
+	pagelen := min(count, maxPageSize)
+	if keep != nil {
+		// rejected items leave gaps, so don't shrink the pages
+		pagelen = maxPageSize
+	}
+	q.Set("pagelen", strconv.Itoa(pagelen))
+	next := a.baseURL + path + "?" + q.Encode()
+
+	var out []T
+	for next != "" && len(out) < count {
+		var page struct {
+			Values []T    `json:"values"`
+			Next   string `json:"next"`
+		}
+		if err := a.get(ctx, next, &page); err != nil {
+			return nil, err
+		}
+		for _, item := range page.Values {
+			if keep != nil {
+				ok, err := keep(item)
+				if err != nil {
+					return nil, err
+				}
+				if !ok {
+					continue
+				}
+			}
+			out = append(out, item)
+			if len(out) == count {
+				break
+			}
+		}
+		next = page.Next
+	}
+	return out, nil
+}
+
+// get fetches an absolute API URL and decodes the JSON response into out.
+func (a *Adapter) get(ctx context.Context, endpoint string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &statusError{path: strings.TrimPrefix(endpoint, a.baseURL), code: resp.StatusCode, status: resp.Status}
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// statusError reports a non-2xx response.
+type statusError struct {
+	path   string
+	code   int
+	status string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("GET %s: %s", e.path, e.status)
+}
+
+// ViewerLogin returns the username of the authenticated user.
+func (a *Adapter) ViewerLogin(ctx context.Context) (string, error) {
+	var u struct {
+		Username string `json:"username"`
+	}
+	if err := a.get(ctx, a.baseURL+"/user", &u); err != nil {
+		return "", err
+	}
+	return u.Username, nil
+}
+
+// RecentRepos returns the most recently created repositories of a workspace,
+// either forks or non-forks, skipping those rejected by keep.
+func (a *Adapter) RecentRepos(ctx context.Context, workspace string, count int, isFork bool, keep func(domain.Repo) bool) ([]domain.Repo, error) {
+	repos, err := paginate(ctx, a, "/repositories/"+url.PathEscape(workspace), url.Values{"sort": {"-created_on"}}, count, func(r bbRepo) (bool, error) {
+		return isForkRepo(r) == isFork && (keep == nil || keep(repoFromBB(r))), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]domain.Repo, 0, len(repos))
+	for _, r := range repos {
+		out = append(out, repoFromBB(r))
+	}
+	return out, nil
+}
+
+// RecentPullRequests returns pull requests authored by the user in any
+// state, newest first, skipping those to repositories rejected by keep. Their
+// Repo is the full destination repository, so keep can tell private ones
+// apart.
+func (a *Adapter) RecentPullRequests(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.PullRequest, error) {
+	query := url.Values{
+		"state": {"OPEN", "MERGED", "DECLINED", "SUPERSEDED"},
+		"sort":  {"-created_on"},
+	}
+	prs, err := paginate(ctx, a, "/pullrequests/"+url.PathEscape(username), query, count, func(pr bbPullRequest) (bool, error) {
+		if keep == nil {
+			return true, nil
+		}
+		repo, err := a.repo(ctx, pr.Destination.Repository.FullName)
+		if err != nil {
+			return false, err
+		}
+		return keep(repo), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]domain.PullRequest, 0, len(prs))
+	for _, pr := range prs {
+		// The pull request only carries a summary of its repository.
+		repo, err := a.repo(ctx, pr.Destination.Repository.FullName)
+		if err != nil {
+			return nil, err
+		}
+		p := domain.PullRequest{
+			Title:     pr.Title,
+			URL:       pr.Links.HTML.Href,
+			State:     stateFromBB(pr.State),
+			CreatedAt: pr.CreatedOn,
+			Number:    pr.ID,
+			Repo:      repo,
+		}
+		if pr.State == "MERGED" {
+			// Bitbucket has no merge date; the last update is the merge.
+			p.MergedAt = pr.UpdatedOn
+		}
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+// Workspaces returns the workspaces the authenticated user has access to.
+func (a *Adapter) Workspaces(ctx context.Context, count int) ([]domain.User, error) {
+	perms, err := paginate[struct {
+		Workspace bbWorkspace `json:"workspace"`
+	}](ctx, a, "/user/permissions/workspaces", nil, count, nil)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]domain.User, 0, len(perms))
+	for _, p := range perms {
+		w := p.Workspace
+		out = append(out, domain.User{Login: w.Slug, Name: w.Name, AvatarURL: w.Links.Avatar.Href, URL: w.Links.HTML.Href})
+	}
+	return out, nil
+}
+
+// repo returns a repository by full name, caching results for the adapter's
+// lifetime. Repositories the token can't see (403 or 404) count as private,
+// since pull requests to them outlive the user's access.
+func (a *Adapter) repo(ctx context.Context, fullName string) (domain.Repo, error) {
+	a.mu.Lock()
+	repo, ok := a.repos[fullName]
+	a.mu.Unlock()
+	if ok {
+		return repo, nil
+	}
+	var (
+		r  bbRepo
+		se *statusError
+	)
+	// full names are "workspace/slug" and keep their slash in the path
+	switch err := a.get(ctx, a.baseURL+"/repositories/"+fullName, &r); {
+	case err == nil:
+		repo = repoFromBB(r)
+	case errors.As(err, &se) && (se.code == http.StatusForbidden || se.code == http.StatusNotFound):
+		repo = domain.Repo{Name: fullName, IsPrivate: true}
+	default:
+		return domain.Repo{}, err
+	}
+	a.mu.Lock()
+	a.repos[fullName] = repo
+	a.mu.Unlock()
+	return repo, nil
+}
+
+func repoFromBB(r bbRepo) domain.Repo {
+	return domain.Repo{
+		Name:        r.FullName,
+		URL:         r.Links.HTML.Href,
+		Description: r.Description,
+		IsPrivate:   r.IsPrivate,
+		IsFork:      isForkRepo(r),
+		CreatedAt:   r.CreatedOn,
+		PushedAt:    r.UpdatedOn,
+	}
+}
+
+func isForkRepo(r bbRepo) bool {
+	return len(r.Parent) > 0 && string(r.Parent) != "null"
+}
+
+// stateFromBB maps Bitbucket states onto the states used by GitHub.
+func stateFromBB(state string) string {
+	switch state {
+	case "DECLINED", "SUPERSEDED":
+		return "CLOSED"
+	default:
+		return state
+	}
+}
@@ -0,0 +1,164 @@
+package bitbucketadapter
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+// fakeBitbucket serves a minimal subset of the Bitbucket Cloud API for the
+// workspace "me".
+func fakeBitbucket(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	var srv *httptest.Server
+	reply := func(w http.ResponseWriter, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(v))
+	}
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, map[string]interface{}{"username": "me"})
+	})
+	mux.HandleFunc("/repositories/me", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "-created_on", r.URL.Query().Get("sort"))
+		if r.URL.Query().Get("page") == "2" {
+			reply(w, map[string]interface{}{"values": []map[string]interface{}{
+				{"full_name": "me/lib", "created_on": "2025-01-01T00:00:00Z"},
+			}})
+			return
+		}
+		reply(w, map[string]interface{}{
+			"values": []map[string]interface{}{
+				{"full_name": "me/app", "description": "An app", "created_on": "2025-02-01T00:00:00Z",
+					"links": map[string]interface{}{"html": map[string]string{"href": "https://bitbucket.example/me/app"}}},
+				{"full_name": "me/fork", "parent": map[string]string{"full_name": "other/fork"}},
+			},
+			"next": srv.URL + "/repositories/me?sort=-created_on&page=2",
+		})
+	})
+	mux.HandleFunc("/pullrequests/me", func(w http.ResponseWriter, r *http.Request) {
+		assert.ElementsMatch(t, []string{"OPEN", "MERGED", "DECLINED", "SUPERSEDED"}, r.URL.Query()["state"])
+		reply(w, map[string]interface{}{"values": []map[string]interface{}{
+			{"id": 3, "title": "Fix", "state": "MERGED", "created_on": "2025-01-01T00:00:00Z", "updated_on": "2025-01-02T00:00:00Z",
+				"destination": map[string]interface{}{"repository": map[string]string{"full_name": "other/lib"}}},
+			{"id": 4, "title": "Idea", "state": "DECLINED",
+				"destination": map[string]interface{}{"repository": map[string]string{"full_name": "corp/secret"}}},
+		}})
+	})
+	mux.HandleFunc("/repositories/other/lib", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, map[string]interface{}{"full_name": "other/lib",
+			"links": map[string]interface{}{"html": map[string]string{"href": "https://bitbucket.example/other/lib"}}})
+	})
+	mux.HandleFunc("/repositories/corp/secret", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, map[string]interface{}{"full_name": "corp/secret", "is_private": true})
+	})
+	mux.HandleFunc("/user/permissions/workspaces", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, map[string]interface{}{"values": []map[string]interface{}{
+			{"workspace": map[string]string{"slug": "team", "name": "The Team"}},
+		}})
+	})
+	srv = httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestAdapter_ViewerLogin(t *testing.T) {
+	srv := fakeBitbucket(t)
+	login, err := New(srv.Client(), srv.URL).ViewerLogin(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "me", login)
+}
+
+func TestAdapter_RecentRepos(t *testing.T) {
+	srv := fakeBitbucket(t)
+	a := New(srv.Client(), srv.URL)
+
+	repos, err := a.RecentRepos(context.Background(), "me", 5, false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Repo{
+		{Name: "me/app", URL: "https://bitbucket.example/me/app", Description: "An app", CreatedAt: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{Name: "me/lib", CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}, repos)
+
+	forks, err := a.RecentRepos(context.Background(), "me", 5, true, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Repo{{Name: "me/fork", IsFork: true}}, forks)
+}
+
+func TestAdapter_RecentPullRequests(t *testing.T) {
+	srv := fakeBitbucket(t)
+
+	prs, err := New(srv.Client(), srv.URL).RecentPullRequests(context.Background(), "me", 5, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.PullRequest{
+		{Title: "Fix", State: "MERGED", Number: 3, Repo: domain.Repo{Name: "other/lib", URL: "https://bitbucket.example/other/lib"},
+			CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), MergedAt: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{Title: "Idea", State: "CLOSED", Number: 4, Repo: domain.Repo{Name: "corp/secret", IsPrivate: true}},
+	}, prs)
+}
+
+func TestAdapter_RecentPullRequestsKeep(t *testing.T) {
+	srv := fakeBitbucket(t)
+	keep := func(r domain.Repo) bool { return !r.IsPrivate }
+
+	prs, err := New(srv.Client(), srv.URL).RecentPullRequests(context.Background(), "me", 5, keep)
+	require.NoError(t, err)
+	require.Len(t, prs, 1)
+	assert.Equal(t, "other/lib", prs[0].Repo.Name)
+}
+
+func TestAdapter_RecentPullRequestsInaccessibleRepo(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/pullrequests/me":
+			w.Header().Set("Content-Type", "application/json")
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{"values": []map[string]interface{}{
+				{"id": 1, "title": "Old", "state": "MERGED",
+					"destination": map[string]interface{}{"repository": map[string]string{"full_name": "corp/gone"}}},
+				{"id": 2, "title": "Hidden", "state": "OPEN",
+					"destination": map[string]interface{}{"repository": map[string]string{"full_name": "corp/locked"}}},
+			}}))
+		case "/repositories/corp/gone":
+			http.NotFound(w, r)
+		default:
+			http.Error(w, "forbidden", http.StatusForbidden)
+		}
+	}))
+	defer srv.Close()
+	a := New(srv.Client(), srv.URL)
+
+	prs, err := a.RecentPullRequests(context.Background(), "me", 5, func(r domain.Repo) bool { return !r.IsPrivate })
+	require.NoError(t, err)
+	assert.Empty(t, prs)
+
+	prs, err = a.RecentPullRequests(context.Background(), "me", 5, nil)
+	require.NoError(t, err)
+	require.Len(t, prs, 2)
+	assert.Equal(t, domain.Repo{Name: "corp/gone", IsPrivate: true}, prs[0].Repo)
+	assert.Equal(t, domain.Repo{Name: "corp/locked", IsPrivate: true}, prs[1].Repo)
+}
+
+func TestAdapter_Workspaces(t *testing.T) {
+	srv := fakeBitbucket(t)
+
+	ws, err := New(srv.Client(), srv.URL).Workspaces(context.Background(), 5)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.User{{Login: "team", Name: "The Team"}}, ws)
+}
+
+func TestAdapter_Error(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "nope", http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	_, err := New(srv.Client(), srv.URL).RecentRepos(context.Background(), "me", 5, false, nil)
+	assert.ErrorContains(t, err, "401")
+}
@@ -0,0 +1,298 @@
+package forgejoadapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+// DefaultURL is the URL of Codeberg, the largest public Forgejo instance.
+const DefaultURL = "https://codeberg.org"
+
+// maxPageSize is the default maximum page size of Forgejo and Gitea instances.
+const maxPageSize = 50
+
+// releaseScanRepos caps how many repositories RecentReleases looks up
+// releases for, as each one takes a request.
+const releaseScanRepos = 100
+
+// Adapter implements ports.ForgejoPort using the Forgejo/Gitea REST API v1.
+// It works with any instance, e.g. Codeberg or a self-hosted Gitea.
+type Adapter struct {
+	client  *http.Client
+	baseURL string
+}
+
+// New returns an adapter for the instance at baseURL (DefaultURL if empty).
+// The client is expected to authenticate its requests; a nil client means
+// http.DefaultClient.
+func New(client *http.Client, baseURL string) *Adapter {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if baseURL == "" {
+		baseURL = DefaultURL
+	}
+	return &Adapter{client: client, baseURL: strings.TrimRight(baseURL, "/")}
+}
+
+// REST lightweight types local to the adapter
+type fjRepo struct {
+	FullName    string    `json:"full_name"`
+	HTMLURL     string    `json:"html_url"`
+	Description string    `json:"description"`
+	Private     bool      `json:"private"`
+	Fork        bool      `json:"fork"`
+	Archived    bool      `json:"archived"`
+	Mirror      bool      `json:"mirror"`
+	OriginalURL string    `json:"original_url"`
+	Topics      []string  `json:"topics"`
+	StarsCount  int       `json:"stars_count"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type fjRelease struct {
+	Name        string    `json:"name"`
+	TagName     string    `json:"tag_name"`
+	HTMLURL     string    `json:"html_url"`
+	Draft       bool      `json:"draft"`
+	Prerelease  bool      `json:"prerelease"`
+	PublishedAt time.Time `json:"published_at"`
+}
+
+type fjHeatmapEntry struct {
+	Timestamp     int64 `json:"timestamp"`
+	Contributions int   `json:"contributions"`
+}
+
+// get fetches path relative to the API root and decodes the JSON response into out.
+func (a *Adapter) get(ctx context.Context, path string, query url.Values, out interface{}) error {
+	endpoint := a.baseURL + "/api/v1" + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("GET %s: %s", path, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// ViewerLogin returns the login of the authenticated user.
+func (a *Adapter) ViewerLogin(ctx context.Context) (string, error) {
+	var user struct {
+		Login string `json:"login"`
+	}
+	if err := a.get(ctx, "/user", nil, &user); err != nil {
+		return "", err
+	}
+	return user.Login, nil
+}
+
+// eachRepo pages through the repositories owned by the user via
+// /repos/search, in the given sort order, newest first, requesting limit
+// repositories per page. It calls fn for each repository until fn returns
+// false or an error, or the list is exhausted.
+func (a *Adapter) eachRepo(ctx context.Context, username, sortBy, mode string, limit int, fn func(domain.Repo) (bool, error)) error {
+	var user struct {
+		ID int64 `json:"id"`
+	}
+	if err := a.get(ctx, "/users/"+url.PathEscape(username), nil, &user); err != nil {
+		return err
+	}
+
+	for page := 1; ; page++ {
+		query := url.Values{
+			"uid":       {strconv.FormatInt(user.ID, 10)},
+			"exclusive": {"true"},
+			"sort":      {sortBy},
+			"order":     {"desc"},
+			"limit":     {strconv.Itoa(limit)},
+			"page":      {strconv.Itoa(page)},
+		}
+		if mode != "" {
+			query.Set("mode", mode)
+		}
+		var result struct {
+			Data []fjRepo `json:"data"`
+		}
+		if err := a.get(ctx, "/repos/search", query, &result); err != nil {
+			return err
+		}
+		for _, r := range result.Data {
+			more, err := fn(repoFromFJ(r))
+			if err != nil || !more {
+				return err
+			}
+		}
+		if len(result.Data) < limit {
+			return nil
+		}
+	}
+}
+
+// searchRepos returns up to count repositories owned by the user, in the
+// given sort order, newest first. Repositories rejected by keep are skipped;
+// a nil keep keeps all repositories.
+func (a *Adapter) searchRepos(ctx context.Context, username, sortBy, mode string, count int, keep func(domain.Repo) bool) ([]domain.Repo, error) {
+	var out []domain.Repo
+	if count <= 0 {
+		return out, nil
+	}
+	limit := min(count, maxPageSize)
+	if keep != nil {
+		// rejected repositories leave gaps, so don't shrink the pages
+		limit = maxPageSize
+	}
+	err := a.eachRepo(ctx, username, sortBy, mode, limit, func(repo domain.Repo) (bool, error) {
+		if keep == nil || keep(repo) {
+			out = append(out, repo)
+		}
+		return len(out) < count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RecentRepos returns the user's most recently created repositories, either
+// forks or sources, skipping those rejected by keep.
+func (a *Adapter) RecentRepos(ctx context.Context, username string, count int, isFork bool, keep func(domain.Repo) bool) ([]domain.Repo, error) {
+	mode := "source"
+	if isFork {
+		mode = "fork"
+	}
+	return a.searchRepos(ctx, username, "created", mode, count, keep)
+}
+
+// RecentReleases returns the user's recently updated repositories that have a
+// published release, with LastRelease set. Drafts, prereleases and
+// repositories rejected by keep are skipped. Repositories are inspected until
+// count releases are found, up to releaseScanRepos of them.
+func (a *Adapter) RecentReleases(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Repo, error) {
+	var out []domain.Repo
+	if count <= 0 {
+		return out, nil
+	}
+	scanned := 0
+	err := a.eachRepo(ctx, username, "updated", "", maxPageSize, func(repo domain.Repo) (bool, error) {
+		if keep != nil && !keep(repo) {
+			return true, nil
+		}
+		scanned++
+		var releases []fjRelease
+		query := url.Values{"draft": {"false"}, "pre-release": {"false"}, "limit": {"1"}}
+		if err := a.get(ctx, "/repos/"+repo.Name+"/releases", query, &releases); err != nil {
+			return false, err
+		}
+		if len(releases) > 0 && !releases[0].Draft && !releases[0].Prerelease {
+			r := releases[0]
+			repo.LastRelease = domain.Release{
+				Name:        r.Name,
+				TagName:     r.TagName,
+				PublishedAt: r.PublishedAt,
+				URL:         r.HTMLURL,
+			}
+			out = append(out, repo)
+		}
+		return len(out) < count && scanned < releaseScanRepos, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RecentStars returns repositories starred by the user, skipping those
+// rejected by keep. The API does not expose when a repository was starred, so
+// StarredAt is left zero.
+func (a *Adapter) RecentStars(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Star, error) {
+	var out []domain.Star
+	limit := min(count, maxPageSize)
+	if keep != nil {
+		limit = maxPageSize
+	}
+	for page := 1; len(out) < count; page++ {
+		var repos []fjRepo
+		query := url.Values{"limit": {strconv.Itoa(limit)}, "page": {strconv.Itoa(page)}}
+		if err := a.get(ctx, "/users/"+url.PathEscape(username)+"/starred", query, &repos); err != nil {
+			return nil, err
+		}
+		for _, r := range repos {
+			repo := repoFromFJ(r)
+			if keep != nil && !keep(repo) {
+				continue
+			}
+			out = append(out, domain.Star{Repo: repo})
+			if len(out) == count {
+				return out, nil
+			}
+		}
+		if len(repos) < limit {
+			break
+		}
+	}
+	return out, nil
+}
+
+// ContributionDays aggregates the user's contribution heatmap per UTC day,
+// oldest first.
+func (a *Adapter) ContributionDays(ctx context.Context, username string, since time.Time) ([]domain.ContributionDay, error) {
+	var heatmap []fjHeatmapEntry
+	if err := a.get(ctx, "/users/"+url.PathEscape(username)+"/heatmap", nil, &heatmap); err != nil {
+		return nil, err
+	}
+	byDay := map[time.Time]int{}
+	for _, e := range heatmap {
+		day := time.Unix(e.Timestamp, 0).UTC().Truncate(24 * time.Hour)
+		if day.Before(since.UTC().Truncate(24 * time.Hour)) {
+			continue
+		}
+		byDay[day] += e.Contributions
+	}
+	out := make([]domain.ContributionDay, 0, len(byDay))
+	for day, n := range byDay {
+		out = append(out, domain.ContributionDay{Date: day, Count: n})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Date.Before(out[j].Date) })
+	return out, nil
+}
+
+func repoFromFJ(r fjRepo) domain.Repo {
+	repo := domain.Repo{
+		Name:        r.FullName,
+		URL:         r.HTMLURL,
+		Description: r.Description,
+		IsPrivate:   r.Private,
+		IsArchived:  r.Archived,
+		IsFork:      r.Fork,
+		Topics:      r.Topics,
+		Stargazers:  r.StarsCount,
+		CreatedAt:   r.CreatedAt,
+		PushedAt:    r.UpdatedAt,
+	}
+	if r.Mirror {
+		repo.MirrorURL = r.OriginalURL
+	}
+	return repo
+}
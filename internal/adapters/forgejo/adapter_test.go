@@ -0,0 +1,166 @@
+package forgejoadapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+// fakeForgejo serves a minimal subset of the Forgejo API for the user "me".
+func fakeForgejo(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	reply := func(w http.ResponseWriter, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(v))
+	}
+	mux.HandleFunc("/api/v1/users/me", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, map[string]interface{}{"id": 42, "login": "me"})
+	})
+	mux.HandleFunc("/api/v1/repos/search", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "42", r.URL.Query().Get("uid"))
+		switch r.URL.Query().Get("mode") {
+		case "fork":
+			reply(w, map[string]interface{}{"ok": true, "data": []map[string]interface{}{
+				{"full_name": "me/fork", "fork": true},
+			}})
+		default:
+			reply(w, map[string]interface{}{"ok": true, "data": []map[string]interface{}{
+				{"full_name": "me/app", "html_url": "https://codeberg.example/me/app", "stars_count": 7, "topics": []string{"go"}},
+				{"full_name": "me/docs", "mirror": true, "original_url": "https://github.com/me/docs.git"},
+			}})
+		}
+	})
+	mux.HandleFunc("/api/v1/repos/me/app/releases", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, []map[string]interface{}{
+			{"name": "v1.0", "tag_name": "v1.0", "html_url": "https://codeberg.example/me/app/releases/tag/v1.0", "published_at": "2025-01-01T00:00:00Z"},
+		})
+	})
+	mux.HandleFunc("/api/v1/repos/me/docs/releases", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, []map[string]interface{}{})
+	})
+	mux.HandleFunc("/api/v1/users/me/starred", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, []map[string]interface{}{{"full_name": "other/lib"}})
+	})
+	mux.HandleFunc("/api/v1/users/me/heatmap", func(w http.ResponseWriter, r *http.Request) {
+		day := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+		reply(w, []map[string]interface{}{
+			{"timestamp": day.Add(-24 * time.Hour).Unix(), "contributions": 9},
+			{"timestamp": day.Add(9 * time.Hour).Unix(), "contributions": 2},
+			{"timestamp": day.Add(17 * time.Hour).Unix(), "contributions": 3},
+			{"timestamp": day.Add(24 * time.Hour).Unix(), "contributions": 1},
+		})
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestAdapter_RecentRepos(t *testing.T) {
+	srv := fakeForgejo(t)
+	a := New(srv.Client(), srv.URL)
+
+	repos, err := a.RecentRepos(context.Background(), "me", 1, false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Repo{{Name: "me/app", URL: "https://codeberg.example/me/app", Stargazers: 7, Topics: []string{"go"}}}, repos)
+
+	forks, err := a.RecentRepos(context.Background(), "me", 5, true, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Repo{{Name: "me/fork", IsFork: true}}, forks)
+}
+
+func TestAdapter_RecentReposKeep(t *testing.T) {
+	srv := fakeForgejo(t)
+	keep := func(r domain.Repo) bool { return r.Name != "me/app" }
+
+	repos, err := New(srv.Client(), srv.URL).RecentRepos(context.Background(), "me", 1, false, keep)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Repo{{Name: "me/docs", MirrorURL: "https://github.com/me/docs.git"}}, repos)
+}
+
+func TestAdapter_RecentReleases(t *testing.T) {
+	srv := fakeForgejo(t)
+
+	repos, err := New(srv.Client(), srv.URL).RecentReleases(context.Background(), "me", 5, nil)
+	require.NoError(t, err)
+	require.Len(t, repos, 1)
+	assert.Equal(t, "me/app", repos[0].Name)
+	assert.Equal(t, "v1.0", repos[0].LastRelease.TagName)
+}
+
+func TestAdapter_RecentReleasesPaging(t *testing.T) {
+	var pages []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/users/me", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 42}`)
+	})
+	mux.HandleFunc("/api/v1/repos/search", func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		assert.Equal(t, strconv.Itoa(maxPageSize), r.URL.Query().Get("limit"))
+		var data []map[string]interface{}
+		if page == "1" {
+			// a full page of repositories without releases
+			for i := 0; i < maxPageSize; i++ {
+				data = append(data, map[string]interface{}{"full_name": "me/none"})
+			}
+		} else {
+			data = append(data,
+				map[string]interface{}{"full_name": "me/secret"},
+				map[string]interface{}{"full_name": "me/app"},
+			)
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{"ok": true, "data": data}))
+	})
+	mux.HandleFunc("/api/v1/repos/me/none/releases", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+	mux.HandleFunc("/api/v1/repos/me/app/releases", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"tag_name": "v2.0", "published_at": "2025-02-01T00:00:00Z"}]`)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	keep := func(r domain.Repo) bool { return r.Name != "me/secret" }
+
+	repos, err := New(srv.Client(), srv.URL).RecentReleases(context.Background(), "me", 1, keep)
+	require.NoError(t, err)
+	require.Len(t, repos, 1)
+	assert.Equal(t, "me/app", repos[0].Name)
+	assert.Equal(t, "v2.0", repos[0].LastRelease.TagName)
+	assert.Equal(t, []string{"1", "2"}, pages)
+}
+
+func TestAdapter_RecentStars(t *testing.T) {
+	srv := fakeForgejo(t)
+
+	stars, err := New(srv.Client(), srv.URL).RecentStars(context.Background(), "me", 5, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Star{{Repo: domain.Repo{Name: "other/lib"}}}, stars)
+}
+
+func TestAdapter_ContributionDays(t *testing.T) {
+	srv := fakeForgejo(t)
+
+	days, err := New(srv.Client(), srv.URL).ContributionDays(context.Background(), "me", time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, []domain.ContributionDay{
+		{Date: time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC), Count: 5},
+		{Date: time.Date(2025, 1, 11, 0, 0, 0, 0, time.UTC), Count: 1},
+	}, days)
+}
+
+func TestAdapter_Error(t *testing.T) {
+	srv := fakeForgejo(t)
+
+	_, err := New(srv.Client(), srv.URL).RecentStars(context.Background(), "ghost", 5, nil)
+	assert.Error(t, err)
+}
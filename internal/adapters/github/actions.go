@@ -0,0 +1,86 @@
+package githubadapter
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+type restWorkflowRuns struct {
+	WorkflowRuns []restWorkflowRun `json:"workflow_runs"`
+}
+
+type restWorkflowRun struct {
+	Name         string    `json:"name"`
+	DisplayTitle string    `json:"display_title"`
+	Status       string    `json:"status"`
+	Conclusion   string    `json:"conclusion"`
+	HeadBranch   string    `json:"head_branch"`
+	Event        string    `json:"event"`
+	HTMLURL      string    `json:"html_url"`
+	RunNumber    int       `json:"run_number"`
+	RunStartedAt time.Time `json:"run_started_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// WorkflowRuns returns the most recent workflow runs of a repository across
+// all workflows, newest first.
+func (a *Adapter) WorkflowRuns(ctx context.Context, owner, name string, count int) ([]domain.WorkflowRun, error) {
+	path := fmt.Sprintf("/repos/%s/%s/actions/runs", url.PathEscape(owner), url.PathEscape(name))
+	var out []domain.WorkflowRun
+	for page := 1; len(out) < count; page++ {
+		var runs restWorkflowRuns
+		query := url.Values{"per_page": {strconv.Itoa(min(count, maxPageSize))}, "page": {strconv.Itoa(page)}}
+		if err := a.restGet(ctx, path, query, &runs); err != nil {
+			return nil, err
+		}
+		for _, r := range runs.WorkflowRuns {
+			out = append(out, workflowRunFromREST(r))
+			if len(out) == count {
+				break
+			}
+		}
+		if len(runs.WorkflowRuns) < min(count, maxPageSize) {
+			break
+		}
+	}
+	return out, nil
+}
+
+// LatestWorkflowRun returns the latest run of a workflow, given by its file
+// name (e.g. "ci.yml") or ID.
+func (a *Adapter) LatestWorkflowRun(ctx context.Context, owner, name, workflow string) (domain.WorkflowRun, error) {
+	path := fmt.Sprintf("/repos/%s/%s/actions/workflows/%s/runs",
+		url.PathEscape(owner), url.PathEscape(name), url.PathEscape(workflow))
+	var runs restWorkflowRuns
+	if err := a.restGet(ctx, path, url.Values{"per_page": {"1"}}, &runs); err != nil {
+		return domain.WorkflowRun{}, err
+	}
+	if len(runs.WorkflowRuns) == 0 {
+		return domain.WorkflowRun{}, nil
+	}
+	return workflowRunFromREST(runs.WorkflowRuns[0]), nil
+}
+
+func workflowRunFromREST(r restWorkflowRun) domain.WorkflowRun {
+	run := domain.WorkflowRun{
+		Name:       r.Name,
+		Title:      r.DisplayTitle,
+		Status:     r.Status,
+		Conclusion: r.Conclusion,
+		Branch:     r.HeadBranch,
+		Event:      r.Event,
+		URL:        r.HTMLURL,
+		RunNumber:  r.RunNumber,
+		StartedAt:  r.RunStartedAt,
+		UpdatedAt:  r.UpdatedAt,
+	}
+	if r.Status == "completed" && !r.RunStartedAt.IsZero() {
+		run.Duration = r.UpdatedAt.Sub(r.RunStartedAt)
+	}
+	return run
+}
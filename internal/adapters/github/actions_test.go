@@ -0,0 +1,65 @@
+package githubadapter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapter_LatestWorkflowRun(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/owner/repo/actions/workflows/ci.yml/runs":
+			assert.Equal(t, "1", r.URL.Query().Get("per_page"))
+			fmt.Fprint(w, `{"workflow_runs": [{
+				"name": "CI", "display_title": "Fix build", "status": "completed", "conclusion": "success",
+				"head_branch": "main", "event": "push", "html_url": "https://github.com/owner/repo/actions/runs/1",
+				"run_number": 42, "run_started_at": "2025-01-01T10:00:00Z", "updated_at": "2025-01-01T10:03:30Z"}]}`)
+		case "/repos/owner/repo/actions/workflows/new.yml/runs":
+			fmt.Fprint(w, `{"workflow_runs": []}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	a := New(nil, WithREST(srv.Client(), srv.URL))
+
+	run, err := a.LatestWorkflowRun(context.Background(), "owner", "repo", "ci.yml")
+	require.NoError(t, err)
+	assert.Equal(t, "success", run.Conclusion)
+	assert.Equal(t, "main", run.Branch)
+	assert.Equal(t, 42, run.RunNumber)
+	assert.Equal(t, 3*time.Minute+30*time.Second, run.Duration)
+
+	run, err = a.LatestWorkflowRun(context.Background(), "owner", "repo", "new.yml")
+	require.NoError(t, err)
+	assert.Empty(t, run.Name)
+
+	_, err = a.LatestWorkflowRun(context.Background(), "owner", "repo", "missing.yml")
+	assert.Error(t, err)
+}
+
+func TestAdapter_WorkflowRuns(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/owner/repo/actions/runs", r.URL.Path)
+		assert.Equal(t, "2", r.URL.Query().Get("per_page"))
+		fmt.Fprint(w, `{"workflow_runs": [
+			{"name": "CI", "status": "in_progress", "run_started_at": "2025-01-01T10:00:00Z", "updated_at": "2025-01-01T10:01:00Z"},
+			{"name": "Release", "status": "completed", "conclusion": "failure"}]}`)
+	}))
+	defer srv.Close()
+
+	runs, err := New(nil, WithREST(srv.Client(), srv.URL)).WorkflowRuns(context.Background(), "owner", "repo", 2)
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	assert.Equal(t, "CI", runs[0].Name)
+	assert.Zero(t, runs[0].Duration, "running workflows have no duration yet")
+	assert.Equal(t, "failure", runs[1].Conclusion)
+}
@@ -3,19 +3,48 @@ package githubadapter
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/shurcooL/githubv4"
 
 	domain "hufschlaeger.net/markscribe/internal/domain"
 )
 
-// Adapter implements ports.GithubPort using the GitHub GraphQL v4 API.
+// Adapter implements ports.GithubPort using the GitHub GraphQL v4 API, and the
+// REST API for the few features GraphQL does not expose.
 type Adapter struct {
-	client *githubv4.Client
+	client     *githubv4.Client
+	rest       *http.Client
+	restURL    string
+	publicOnly bool
 }
 
-func New(client *githubv4.Client) *Adapter { // constructor kept simple for now
-	return &Adapter{client: client}
+func New(client *githubv4.Client, opts ...Option) *Adapter {
+	a := &Adapter{client: client, rest: http.DefaultClient, restURL: DefaultRESTURL}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// WithPublicOnly restricts repository listings to public repositories, so
+// private ones don't use up the requested count before the service filters
+// them out.
+func WithPublicOnly() Option {
+	return func(a *Adapter) { a.publicOnly = true }
+}
+
+// privacy returns the privacy argument for repository connections: PUBLIC
+// with WithPublicOnly, otherwise null for repositories of any visibility.
+func (a *Adapter) privacy() *githubv4.RepositoryPrivacy {
+	if !a.publicOnly {
+		return nil
+	}
+	p := githubv4.RepositoryPrivacyPublic
+	return &p
 }
 
 // GraphQL lightweight types local to the adapter
@@ -30,15 +59,44 @@ type qlRelease struct {
 	}
 }
 
+type qlReleaseDetails struct {
+	Name          githubv4.String
+	TagName       githubv4.String
+	PublishedAt   githubv4.DateTime
+	URL           githubv4.String
+	IsPrerelease  githubv4.Boolean
+	IsDraft       githubv4.Boolean
+	Description   githubv4.String
+	ReleaseAssets struct {
+		Nodes []struct {
+			Name          githubv4.String
+			Size          githubv4.Int
+			DownloadCount githubv4.Int
+			DownloadURL   githubv4.String
+		}
+	} `graphql:"releaseAssets(first: 50)"`
+}
+
 type qlRepository struct {
 	NameWithOwner githubv4.String
 	URL           githubv4.String
 	Description   githubv4.String
 	IsPrivate     githubv4.Boolean
+	IsArchived    githubv4.Boolean
+	IsFork        githubv4.Boolean
+	CreatedAt     githubv4.DateTime
 	PushedAt      githubv4.DateTime // ← NEU hinzufügen!
+	MirrorURL     githubv4.String
 	Stargazers    struct {
 		TotalCount githubv4.Int
 	}
+	RepositoryTopics struct {
+		Nodes []struct {
+			Topic struct {
+				Name githubv4.String
+			}
+		}
+	} `graphql:"repositoryTopics(first: 20)"`
 	Releases qlRelease `graphql:"releases(last: 1)"`
 }
 
@@ -49,11 +107,43 @@ type qlUser struct {
 	URL       githubv4.String
 }
 
+type qlLabels struct {
+	Nodes []struct {
+		Name githubv4.String
+	}
+}
+
 type qlPullRequest struct {
+	Number     githubv4.Int
 	URL        githubv4.String
 	Title      githubv4.String
 	State      githubv4.PullRequestState
 	CreatedAt  githubv4.DateTime
+	MergedAt   githubv4.DateTime
+	Additions  githubv4.Int
+	Deletions  githubv4.Int
+	Labels     qlLabels `graphql:"labels(first: 10)"`
+	Repository qlRepository
+}
+
+type qlIssue struct {
+	Number     githubv4.Int
+	URL        githubv4.String
+	Title      githubv4.String
+	State      githubv4.IssueState
+	CreatedAt  githubv4.DateTime
+	Labels     qlLabels `graphql:"labels(first: 10)"`
+	Repository qlRepository
+}
+
+type qlDiscussion struct {
+	Title      githubv4.String
+	URL        githubv4.String
+	CreatedAt  githubv4.DateTime
+	IsAnswered githubv4.Boolean
+	Category   struct {
+		Name githubv4.String
+	}
 	Repository qlRepository
 }
 
@@ -70,11 +160,39 @@ type recentReposQuery struct {
 		Login        githubv4.String
 		Repositories struct {
 			TotalCount githubv4.Int
+			PageInfo   qlPageInfo
 			Edges      []struct {
 				Cursor githubv4.String
 				Node   qlRepository
 			}
-		} `graphql:"repositories(first: $count, privacy: PUBLIC, isFork: $isFork, ownerAffiliations: OWNER, orderBy: {field: CREATED_AT, direction: DESC})"`
+		} `graphql:"repositories(first: $first, after: $after, privacy: $privacy, isFork: $isFork, ownerAffiliations: OWNER, orderBy: {field: CREATED_AT, direction: DESC})"`
+	} `graphql:"user(login:$username)"`
+}
+
+type topReposQuery struct {
+	User struct {
+		Login        githubv4.String
+		Repositories struct {
+			PageInfo qlPageInfo
+			Nodes    []qlRepository
+		} `graphql:"repositories(first: $first, after: $after, privacy: $privacy, isFork: false, ownerAffiliations: OWNER, orderBy: {field: STARGAZERS, direction: DESC})"`
+	} `graphql:"user(login:$username)"`
+}
+
+type trendingReposQuery struct {
+	User struct {
+		Login        githubv4.String
+		Repositories struct {
+			PageInfo qlPageInfo
+			Nodes    []struct {
+				qlRepository
+				RecentStargazers struct {
+					Edges []struct {
+						StarredAt githubv4.DateTime
+					}
+				} `graphql:"recentStargazers: stargazers(first: 100, orderBy: {field: STARRED_AT, direction: DESC})"`
+			}
+		} `graphql:"repositories(first: $first, after: $after, privacy: $privacy, isFork: false, ownerAffiliations: OWNER, orderBy: {field: STARGAZERS, direction: DESC})"`
 	} `graphql:"user(login:$username)"`
 }
 
@@ -82,22 +200,151 @@ type repoQuery struct {
 	Repository qlRepository `graphql:"repository(owner:$owner, name:$name)"`
 }
 
+type releasesQuery struct {
+	Repository struct {
+		Releases struct {
+			PageInfo qlPageInfo
+			Nodes    []qlReleaseDetails
+		} `graphql:"releases(first: $first, after: $after, orderBy: {field: CREATED_AT, direction: DESC})"`
+	} `graphql:"repository(owner:$owner, name:$name)"`
+}
+
+type contributorsQuery struct {
+	Repository struct {
+		DefaultBranchRef struct {
+			Target struct {
+				Commit struct {
+					History struct {
+						PageInfo qlPageInfo
+						Nodes    []struct {
+							Author struct {
+								User qlUser
+							}
+						}
+					} `graphql:"history(first: $first, after: $after)"`
+				} `graphql:"... on Commit"`
+			}
+		}
+	} `graphql:"repository(owner:$owner, name:$name)"`
+}
+
+type openIssuesQuery struct {
+	Repository struct {
+		Issues struct {
+			PageInfo qlPageInfo
+			Nodes    []qlIssue
+		} `graphql:"issues(first: $first, after: $after, states: OPEN, labels: $labels, orderBy: {field: CREATED_AT, direction: DESC})"`
+	} `graphql:"repository(owner:$owner, name:$name)"`
+}
+
+type latestReleaseQuery struct {
+	Repository struct {
+		LatestRelease qlReleaseDetails
+	} `graphql:"repository(owner:$owner, name:$name)"`
+}
+
+type milestonesQuery struct {
+	Repository struct {
+		Milestones struct {
+			PageInfo qlPageInfo
+			Nodes    []struct {
+				Title              githubv4.String
+				Description        githubv4.String
+				URL                githubv4.String
+				State              githubv4.MilestoneState
+				DueOn              githubv4.DateTime
+				ProgressPercentage githubv4.Float
+				OpenIssues         struct {
+					TotalCount githubv4.Int
+				} `graphql:"openIssues: issues(states: OPEN)"`
+				ClosedIssues struct {
+					TotalCount githubv4.Int
+				} `graphql:"closedIssues: issues(states: CLOSED)"`
+			}
+		} `graphql:"milestones(first: $first, after: $after, states: OPEN, orderBy: {field: DUE_DATE, direction: ASC})"`
+	} `graphql:"repository(owner:$owner, name:$name)"`
+}
+
+type languagesQuery struct {
+	Repository struct {
+		Languages struct {
+			TotalSize githubv4.Int
+			Edges     []struct {
+				Size githubv4.Int
+				Node struct {
+					Name  githubv4.String
+					Color githubv4.String
+				}
+			}
+		} `graphql:"languages(first: 100, orderBy: {field: SIZE, direction: DESC})"`
+	} `graphql:"repository(owner:$owner, name:$name)"`
+}
+
 type viewerQuery struct {
 	Viewer struct {
 		Login githubv4.String
 	}
 }
 
+type profileQuery struct {
+	User struct {
+		Login      githubv4.String
+		Name       githubv4.String
+		Bio        githubv4.String
+		Company    githubv4.String
+		Location   githubv4.String
+		AvatarURL  githubv4.String
+		URL        githubv4.String
+		WebsiteURL githubv4.String
+		CreatedAt  githubv4.DateTime
+		Status     struct {
+			Emoji   githubv4.String
+			Message githubv4.String
+		}
+		Followers struct {
+			TotalCount githubv4.Int
+		}
+		Following struct {
+			TotalCount githubv4.Int
+		}
+		Repositories struct {
+			TotalCount githubv4.Int
+		} `graphql:"repositories(privacy: PUBLIC, ownerAffiliations: OWNER)"`
+	} `graphql:"user(login:$login)"`
+}
+
 type followersQuery struct {
 	User struct {
 		Login     githubv4.String
 		Followers struct {
 			TotalCount githubv4.Int
+			PageInfo   qlPageInfo
 			Edges      []struct {
 				Cursor githubv4.String
 				Node   qlUser
 			}
-		} `graphql:"followers(first: $count)"`
+		} `graphql:"followers(first: $first, after: $after)"`
+	} `graphql:"user(login:$username)"`
+}
+
+type followingQuery struct {
+	User struct {
+		Login     githubv4.String
+		Following struct {
+			TotalCount githubv4.Int
+			PageInfo   qlPageInfo
+			Nodes      []qlUser
+		} `graphql:"following(first: $first, after: $after)"`
+	} `graphql:"user(login:$username)"`
+}
+
+type organizationsQuery struct {
+	User struct {
+		Login         githubv4.String
+		Organizations struct {
+			PageInfo qlPageInfo
+			Nodes    []qlUser
+		} `graphql:"organizations(first: $first, after: $after)"`
 	} `graphql:"user(login:$username)"`
 }
 
@@ -106,11 +353,12 @@ type recentPullRequestsQuery struct {
 		Login        githubv4.String
 		PullRequests struct {
 			TotalCount githubv4.Int
+			PageInfo   qlPageInfo
 			Edges      []struct {
 				Cursor githubv4.String
 				Node   qlPullRequest
 			}
-		} `graphql:"pullRequests(first: $count, orderBy: {field: CREATED_AT, direction: DESC})"`
+		} `graphql:"pullRequests(first: $first, after: $after, states: $states, orderBy: {field: CREATED_AT, direction: DESC})"`
 	} `graphql:"user(login:$username)"`
 }
 
@@ -119,6 +367,7 @@ type recentReleasesQuery struct {
 		Login                     githubv4.String
 		RepositoriesContributedTo struct {
 			TotalCount githubv4.Int
+			PageInfo   qlPageInfo
 			Edges      []struct {
 				Cursor githubv4.String
 				Node   struct {
@@ -126,7 +375,7 @@ type recentReleasesQuery struct {
 					Releases qlRelease `graphql:"releases(first: 10, orderBy: {field: CREATED_AT, direction: DESC})"`
 				}
 			}
-		} `graphql:"repositoriesContributedTo(first: 100, after:$after, includeUserRepositories: true, contributionTypes: COMMIT, privacy: PUBLIC)"`
+		} `graphql:"repositoriesContributedTo(first: $first, after: $after, includeUserRepositories: true, contributionTypes: COMMIT, privacy: $privacy)"`
 	} `graphql:"user(login:$username)"`
 }
 
@@ -135,11 +384,12 @@ type gistsQuery struct {
 		Login githubv4.String
 		Gists struct {
 			TotalCount githubv4.Int
+			PageInfo   qlPageInfo
 			Edges      []struct {
 				Cursor githubv4.String
 				Node   qlGist
 			}
-		} `graphql:"gists(first: $count, orderBy: {field: CREATED_AT, direction: DESC})"`
+		} `graphql:"gists(first: $first, after: $after, orderBy: {field: CREATED_AT, direction: DESC})"`
 	} `graphql:"user(login:$username)"`
 }
 
@@ -148,12 +398,13 @@ type recentStarsQuery struct {
 		Login githubv4.String
 		Stars struct {
 			TotalCount githubv4.Int
+			PageInfo   qlPageInfo
 			Edges      []struct {
 				Cursor    githubv4.String
 				StarredAt githubv4.DateTime
 				Node      qlRepository
 			}
-		} `graphql:"starredRepositories(first: $count, after:$after, orderBy: {field: STARRED_AT, direction: DESC})"`
+		} `graphql:"starredRepositories(first: $first, after: $after, orderBy: {field: STARRED_AT, direction: DESC})"`
 	} `graphql:"user(login:$username)"`
 }
 
@@ -161,44 +412,114 @@ type recentContributionsQuery struct {
 	User struct {
 		Login        githubv4.String
 		Repositories struct {
-			Edges []struct {
+			PageInfo qlPageInfo
+			Edges    []struct {
 				Node qlRepository
 			}
-		} `graphql:"repositories(first: 100, privacy: PUBLIC, isFork: false, ownerAffiliations: [OWNER, COLLABORATOR, ORGANIZATION_MEMBER], orderBy: {field: PUSHED_AT, direction: DESC})"`
+		} `graphql:"repositories(first: $first, after: $after, privacy: $privacy, isFork: false, ownerAffiliations: [OWNER, COLLABORATOR, ORGANIZATION_MEMBER], orderBy: {field: PUSHED_AT, direction: DESC})"`
 	} `graphql:"user(login: $username)"`
 }
 
+type userIDQuery struct {
+	User struct {
+		ID githubv4.ID
+	} `graphql:"user(login:$username)"`
+}
+
+type recentCommitsQuery struct {
+	User struct {
+		Login        githubv4.String
+		Repositories struct {
+			PageInfo qlPageInfo
+			Nodes    []struct {
+				qlRepository
+				DefaultBranchRef struct {
+					Target struct {
+						Commit struct {
+							History struct {
+								Nodes []struct {
+									AbbreviatedOid  githubv4.String
+									MessageHeadline githubv4.String
+									URL             githubv4.String
+									CommittedDate   githubv4.DateTime
+								}
+							} `graphql:"history(author: $author, first: $first)"`
+						} `graphql:"... on Commit"`
+					}
+				}
+			}
+		} `graphql:"repositories(first: $repos, after: $after, privacy: $privacy, isFork: false, ownerAffiliations: [OWNER, COLLABORATOR, ORGANIZATION_MEMBER], orderBy: {field: PUSHED_AT, direction: DESC})"`
+	} `graphql:"user(login:$username)"`
+}
+
 type recentIssuesQuery struct {
+	User struct {
+		Login  githubv4.String
+		Issues struct {
+			PageInfo qlPageInfo
+			Nodes    []qlIssue
+		} `graphql:"issues(first: $first, after: $after, states: $states, orderBy: {field: CREATED_AT, direction: DESC})"`
+	} `graphql:"user(login:$username)"`
+}
+
+type recentReviewsQuery struct {
 	User struct {
 		Login                   githubv4.String
 		ContributionsCollection struct {
-			IssueContributionsByRepository []struct {
-				Contributions struct {
-					Edges []struct {
-						Cursor githubv4.String
-						Node   struct {
-							OccurredAt githubv4.DateTime
-							Issue      struct {
-								Title githubv4.String
-							}
-						}
+			PullRequestReviewContributions struct {
+				PageInfo qlPageInfo
+				Nodes    []struct {
+					OccurredAt        githubv4.DateTime
+					PullRequestReview struct {
+						State githubv4.PullRequestReviewState
+						URL   githubv4.String
 					}
-				} `graphql:"contributions(first: 1)"`
-				Repository qlRepository
-			} `graphql:"issueContributionsByRepository(maxRepositories: 100)"`
+					PullRequest qlPullRequest
+				}
+			} `graphql:"pullRequestReviewContributions(first: $first, after: $after, orderBy: {direction: DESC})"`
 		}
 	} `graphql:"user(login:$username)"`
 }
 
+type recentDiscussionsQuery struct {
+	User struct {
+		Login                 githubv4.String
+		RepositoryDiscussions struct {
+			PageInfo qlPageInfo
+			Nodes    []qlDiscussion
+		} `graphql:"repositoryDiscussions(first: $first, after: $after, orderBy: {field: CREATED_AT, direction: DESC})"`
+	} `graphql:"user(login:$username)"`
+}
+
+type recentDiscussionCommentsQuery struct {
+	User struct {
+		Login                        githubv4.String
+		RepositoryDiscussionComments struct {
+			PageInfo qlPrevPageInfo
+			Nodes    []struct {
+				URL        githubv4.String
+				CreatedAt  githubv4.DateTime
+				Discussion qlDiscussion
+			}
+		} `graphql:"repositoryDiscussionComments(last: $last, before: $before)"`
+	} `graphql:"user(login:$username)"`
+}
+
 type sponsorsQuery struct {
 	User struct {
 		Login                    githubv4.String
 		SponsorshipsAsMaintainer struct {
 			TotalCount githubv4.Int
+			PageInfo   qlPageInfo
 			Edges      []struct {
 				Cursor githubv4.String
 				Node   struct {
-					CreatedAt     githubv4.DateTime
+					CreatedAt        githubv4.DateTime
+					IsOneTimePayment githubv4.Boolean
+					Tier             struct {
+						Name                  githubv4.String
+						MonthlyPriceInDollars githubv4.Int
+					}
 					SponsorEntity struct {
 						Typename     githubv4.String `graphql:"__typename"`
 						User         qlUser          `graphql:"... on User"`
@@ -206,40 +527,316 @@ type sponsorsQuery struct {
 					}
 				}
 			}
-		} `graphql:"sponsorshipsAsMaintainer(first: $count, orderBy: {field: CREATED_AT, direction: DESC})"`
+		} `graphql:"sponsorshipsAsMaintainer(first: $first, after: $after, orderBy: {field: CREATED_AT, direction: DESC})"`
+	} `graphql:"user(login:$username)"`
+}
+
+type sponsorsGoalQuery struct {
+	User struct {
+		Login           githubv4.String
+		SponsorsListing struct {
+			ActiveGoal struct {
+				Kind            githubv4.String
+				Title           githubv4.String
+				Description     githubv4.String
+				PercentComplete githubv4.Int
+				TargetValue     githubv4.Int
+			}
+		}
+	} `graphql:"user(login:$username)"`
+}
+
+type sponsoringQuery struct {
+	User struct {
+		Login      githubv4.String
+		Sponsoring struct {
+			PageInfo qlPageInfo
+			Nodes    []struct {
+				Typename     githubv4.String `graphql:"__typename"`
+				User         qlUser          `graphql:"... on User"`
+				Organization qlUser          `graphql:"... on Organization"`
+			}
+		} `graphql:"sponsoring(first: $first, after: $after, orderBy: {field: LOGIN, direction: ASC})"`
+	} `graphql:"user(login:$username)"`
+}
+
+type pinnedItemsQuery struct {
+	User struct {
+		Login       githubv4.String
+		PinnedItems struct {
+			Nodes []struct {
+				Typename   githubv4.String `graphql:"__typename"`
+				Repository qlRepository    `graphql:"... on Repository"`
+				Gist       qlGist          `graphql:"... on Gist"`
+			}
+		} `graphql:"pinnedItems(first: 6, types: [REPOSITORY, GIST])"`
 	} `graphql:"user(login:$username)"`
 }
 
 // RecentRepos returns recent repositories for the given user.
-func (a *Adapter) RecentRepos(ctx context.Context, username string, count int, isFork bool) ([]domain.Repo, error) {
-	var q recentReposQuery
+func (a *Adapter) RecentRepos(ctx context.Context, username string, count int, isFork bool, keep func(domain.Repo) bool) ([]domain.Repo, error) {
+	return paginate(count, keep, func(first int, after *githubv4.String) ([]domain.Repo, qlPageInfo, error) {
+		var q recentReposQuery
+		variables := map[string]interface{}{
+			"username": githubv4.String(username),
+			"first":    githubv4.Int(first),
+			"after":    after,
+			"isFork":   githubv4.Boolean(isFork),
+			"privacy":  a.privacy(),
+		}
+		if err := a.client.Query(ctx, &q, variables); err != nil {
+			return nil, qlPageInfo{}, err
+		}
+
+		var repos []domain.Repo
+		for _, edge := range q.User.Repositories.Edges {
+			repos = append(repos, repoFromQL(edge.Node))
+		}
+		return repos, q.User.Repositories.PageInfo, nil
+	})
+}
+
+// TopRepos returns the user's own non-fork repositories ordered by stargazers desc.
+func (a *Adapter) TopRepos(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Repo, error) {
+	return paginate(count, keep, func(first int, after *githubv4.String) ([]domain.Repo, qlPageInfo, error) {
+		var q topReposQuery
+		variables := map[string]interface{}{
+			"username": githubv4.String(username),
+			"first":    githubv4.Int(first),
+			"after":    after,
+			"privacy":  a.privacy(),
+		}
+		if err := a.client.Query(ctx, &q, variables); err != nil {
+			return nil, qlPageInfo{}, err
+		}
+		var repos []domain.Repo
+		for _, node := range q.User.Repositories.Nodes {
+			repos = append(repos, repoFromQL(node))
+		}
+		return repos, q.User.Repositories.PageInfo, nil
+	})
+}
+
+// trendingScanRepos limits how many of the user's most starred repositories
+// are checked for recently gained stars.
+const trendingScanRepos = 100
+
+// TrendingRepos returns the user's own non-fork repositories with
+// StargazersDelta set to the number of stars gained since the given time.
+// Only the latest 100 stargazers per repository are inspected, so the delta
+// is capped at 100.
+func (a *Adapter) TrendingRepos(ctx context.Context, username string, since time.Time) ([]domain.Repo, error) {
+	return paginate(trendingScanRepos, nil, func(first int, after *githubv4.String) ([]domain.Repo, qlPageInfo, error) {
+		var q trendingReposQuery
+		variables := map[string]interface{}{
+			"username": githubv4.String(username),
+			"first":    githubv4.Int(first),
+			"after":    after,
+			"privacy":  a.privacy(),
+		}
+		if err := a.client.Query(ctx, &q, variables); err != nil {
+			return nil, qlPageInfo{}, err
+		}
+		var repos []domain.Repo
+		for _, node := range q.User.Repositories.Nodes {
+			r := repoFromQL(node.qlRepository)
+			for _, edge := range node.RecentStargazers.Edges {
+				// edges are ordered by starredAt desc
+				if edge.StarredAt.Before(since) {
+					break
+				}
+				r.StargazersDelta++
+			}
+			repos = append(repos, r)
+		}
+		return repos, q.User.Repositories.PageInfo, nil
+	})
+}
+
+// Repo returns a repository by owner/name.
+func (a *Adapter) Repo(ctx context.Context, owner, name string) (domain.Repo, error) {
+	var q repoQuery
 	variables := map[string]interface{}{
-		"username": githubv4.String(username),
-		"count":    githubv4.Int(count),
-		"isFork":   githubv4.Boolean(isFork),
+		"owner": githubv4.String(owner),
+		"name":  githubv4.String(name),
 	}
 	if err := a.client.Query(ctx, &q, variables); err != nil {
+		return domain.Repo{}, err
+	}
+	return repoFromQL(q.Repository), nil
+}
+
+// Releases returns the published (non-draft) releases of a repository, newest first,
+// including release notes and assets.
+func (a *Adapter) Releases(ctx context.Context, owner, name string, count int) ([]domain.Release, error) {
+	return paginate(count, nil, func(first int, after *githubv4.String) ([]domain.Release, qlPageInfo, error) {
+		var q releasesQuery
+		variables := map[string]interface{}{
+			"owner": githubv4.String(owner),
+			"name":  githubv4.String(name),
+			"first": githubv4.Int(first),
+			"after": after,
+		}
+		if err := a.client.Query(ctx, &q, variables); err != nil {
+			return nil, qlPageInfo{}, err
+		}
+		var out []domain.Release
+		for _, node := range q.Repository.Releases.Nodes {
+			if bool(node.IsDraft) {
+				continue
+			}
+			out = append(out, releaseFromQL(node))
+		}
+		return out, q.Repository.Releases.PageInfo, nil
+	})
+}
+
+// contributorScanCommits limits how much of the default branch history
+// Contributors inspects.
+const contributorScanCommits = 1000
+
+// Contributors returns the users who authored commits in the latest 1000 commits
+// of a repository's default branch, with their commit counts in that range.
+// Commits without a linked GitHub user are skipped.
+func (a *Adapter) Contributors(ctx context.Context, owner, name string) ([]domain.Contributor, error) {
+	authors, err := paginate(contributorScanCommits, nil, func(first int, after *githubv4.String) ([]qlUser, qlPageInfo, error) {
+		var q contributorsQuery
+		variables := map[string]interface{}{
+			"owner": githubv4.String(owner),
+			"name":  githubv4.String(name),
+			"first": githubv4.Int(first),
+			"after": after,
+		}
+		if err := a.client.Query(ctx, &q, variables); err != nil {
+			return nil, qlPageInfo{}, err
+		}
+		history := q.Repository.DefaultBranchRef.Target.Commit.History
+		var users []qlUser
+		for _, node := range history.Nodes {
+			users = append(users, node.Author.User)
+		}
+		return users, history.PageInfo, nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	var repos []domain.Repo
-	for _, edge := range q.User.Repositories.Edges {
-		repos = append(repos, repoFromQL(edge.Node))
+	byLogin := map[string]int{}
+	var out []domain.Contributor
+	for _, u := range authors {
+		if u.Login == "" {
+			continue
+		}
+		i, ok := byLogin[string(u.Login)]
+		if !ok {
+			i = len(out)
+			byLogin[string(u.Login)] = i
+			out = append(out, domain.Contributor{User: userFromQL(u)})
+		}
+		out[i].Commits++
 	}
-	return repos, nil
+	return out, nil
 }
 
-// Repo returns a repository by owner/name.
-func (a *Adapter) Repo(ctx context.Context, owner, name string) (domain.Repo, error) {
-	var q repoQuery
+// OpenIssues returns the open issues of a repository, newest first, optionally
+// restricted to issues carrying any of the given labels.
+func (a *Adapter) OpenIssues(ctx context.Context, owner, name string, count int, labels []string) ([]domain.Issue, error) {
+	var issueLabels *[]githubv4.String
+	if len(labels) > 0 {
+		issueLabels = &[]githubv4.String{}
+		for _, l := range labels {
+			*issueLabels = append(*issueLabels, githubv4.String(l))
+		}
+	}
+	return paginate(count, nil, func(first int, after *githubv4.String) ([]domain.Issue, qlPageInfo, error) {
+		var q openIssuesQuery
+		variables := map[string]interface{}{
+			"owner":  githubv4.String(owner),
+			"name":   githubv4.String(name),
+			"first":  githubv4.Int(first),
+			"after":  after,
+			"labels": issueLabels,
+		}
+		if err := a.client.Query(ctx, &q, variables); err != nil {
+			return nil, qlPageInfo{}, err
+		}
+		var out []domain.Issue
+		for _, node := range q.Repository.Issues.Nodes {
+			out = append(out, issueFromQL(node))
+		}
+		return out, q.Repository.Issues.PageInfo, nil
+	})
+}
+
+// LatestRelease returns the release GitHub marks as latest for a repository.
+// The zero value is returned if the repository has no release.
+func (a *Adapter) LatestRelease(ctx context.Context, owner, name string) (domain.Release, error) {
+	var q latestReleaseQuery
 	variables := map[string]interface{}{
 		"owner": githubv4.String(owner),
 		"name":  githubv4.String(name),
 	}
 	if err := a.client.Query(ctx, &q, variables); err != nil {
-		return domain.Repo{}, err
+		return domain.Release{}, err
 	}
-	return repoFromQL(q.Repository), nil
+	return releaseFromQL(q.Repository.LatestRelease), nil
+}
+
+// Milestones returns the open milestones of a repository ordered by due date.
+func (a *Adapter) Milestones(ctx context.Context, owner, name string, count int) ([]domain.Milestone, error) {
+	return paginate(count, nil, func(first int, after *githubv4.String) ([]domain.Milestone, qlPageInfo, error) {
+		var q milestonesQuery
+		variables := map[string]interface{}{
+			"owner": githubv4.String(owner),
+			"name":  githubv4.String(name),
+			"first": githubv4.Int(first),
+			"after": after,
+		}
+		if err := a.client.Query(ctx, &q, variables); err != nil {
+			return nil, qlPageInfo{}, err
+		}
+		var out []domain.Milestone
+		for _, m := range q.Repository.Milestones.Nodes {
+			out = append(out, domain.Milestone{
+				Title:              string(m.Title),
+				Description:        string(m.Description),
+				URL:                string(m.URL),
+				State:              string(m.State),
+				DueOn:              m.DueOn.Time,
+				ProgressPercentage: float64(m.ProgressPercentage),
+				OpenIssues:         int(m.OpenIssues.TotalCount),
+				ClosedIssues:       int(m.ClosedIssues.TotalCount),
+			})
+		}
+		return out, q.Repository.Milestones.PageInfo, nil
+	})
+}
+
+// Languages returns the languages of a repository ordered by size desc,
+// with their share of the repository's code in percent.
+func (a *Adapter) Languages(ctx context.Context, owner, name string) ([]domain.Language, error) {
+	var q languagesQuery
+	variables := map[string]interface{}{
+		"owner": githubv4.String(owner),
+		"name":  githubv4.String(name),
+	}
+	if err := a.client.Query(ctx, &q, variables); err != nil {
+		return nil, err
+	}
+	total := int(q.Repository.Languages.TotalSize)
+	var out []domain.Language
+	for _, edge := range q.Repository.Languages.Edges {
+		l := domain.Language{
+			Name:  string(edge.Node.Name),
+			Color: string(edge.Node.Color),
+			Size:  int(edge.Size),
+		}
+		if total > 0 {
+			l.Percent = float64(l.Size) * 100 / float64(total)
+		}
+		out = append(out, l)
+	}
+	return out, nil
 }
 
 // ViewerLogin returns the login of the authenticated viewer
@@ -251,67 +848,138 @@ func (a *Adapter) ViewerLogin(ctx context.Context) (string, error) {
 	return string(q.Viewer.Login), nil
 }
 
-// Followers returns the followers for a user
-func (a *Adapter) Followers(ctx context.Context, username string, count int) ([]domain.User, error) {
-	var q followersQuery
+// Profile returns the public profile of the given user.
+func (a *Adapter) Profile(ctx context.Context, login string) (domain.Profile, error) {
+	var q profileQuery
 	variables := map[string]interface{}{
-		"username": githubv4.String(username),
-		"count":    githubv4.Int(count),
+		"login": githubv4.String(login),
 	}
 	if err := a.client.Query(ctx, &q, variables); err != nil {
-		return nil, err
+		return domain.Profile{}, err
 	}
-	var users []domain.User
-	for _, edge := range q.User.Followers.Edges {
-		users = append(users, userFromQL(edge.Node))
-	}
-	return users, nil
+	u := q.User
+	return domain.Profile{
+		Login:         string(u.Login),
+		Name:          string(u.Name),
+		Bio:           string(u.Bio),
+		Company:       string(u.Company),
+		Location:      string(u.Location),
+		AvatarURL:     string(u.AvatarURL),
+		URL:           string(u.URL),
+		WebsiteURL:    string(u.WebsiteURL),
+		StatusEmoji:   string(u.Status.Emoji),
+		StatusMessage: string(u.Status.Message),
+		CreatedAt:     u.CreatedAt.Time,
+		Followers:     int(u.Followers.TotalCount),
+		Following:     int(u.Following.TotalCount),
+		PublicRepos:   int(u.Repositories.TotalCount),
+	}, nil
 }
 
-// RecentPullRequests returns recent pull requests created by the user.
-func (a *Adapter) RecentPullRequests(ctx context.Context, username string, count int) ([]domain.PullRequest, error) {
-	var q recentPullRequestsQuery
-	variables := map[string]interface{}{
-		"username": githubv4.String(username),
-		"count":    githubv4.Int(count + 1), // +1 to allow skipping meta-repo later
-	}
-	if err := a.client.Query(ctx, &q, variables); err != nil {
-		return nil, err
-	}
-	var prs []domain.PullRequest
-	for _, edge := range q.User.PullRequests.Edges {
-		pr := edge.Node
-		dpr := domain.PullRequest{
-			Title:     string(pr.Title),
-			URL:       string(pr.URL),
-			State:     string(pr.State),
-			CreatedAt: pr.CreatedAt.Time,
-			Repo:      repoFromQL(pr.Repository),
+// Followers returns the followers for a user
+func (a *Adapter) Followers(ctx context.Context, username string, count int) ([]domain.User, error) {
+	return paginate(count, nil, func(first int, after *githubv4.String) ([]domain.User, qlPageInfo, error) {
+		var q followersQuery
+		variables := map[string]interface{}{
+			"username": githubv4.String(username),
+			"first":    githubv4.Int(first),
+			"after":    after,
+		}
+		if err := a.client.Query(ctx, &q, variables); err != nil {
+			return nil, qlPageInfo{}, err
 		}
-		prs = append(prs, dpr)
-		if len(prs) >= count {
-			break
+		var users []domain.User
+		for _, edge := range q.User.Followers.Edges {
+			users = append(users, userFromQL(edge.Node))
+		}
+		return users, q.User.Followers.PageInfo, nil
+	})
+}
+
+// Following returns the users the given user follows.
+func (a *Adapter) Following(ctx context.Context, username string, count int) ([]domain.User, error) {
+	return paginate(count, nil, func(first int, after *githubv4.String) ([]domain.User, qlPageInfo, error) {
+		var q followingQuery
+		variables := map[string]interface{}{
+			"username": githubv4.String(username),
+			"first":    githubv4.Int(first),
+			"after":    after,
+		}
+		if err := a.client.Query(ctx, &q, variables); err != nil {
+			return nil, qlPageInfo{}, err
+		}
+		var users []domain.User
+		for _, node := range q.User.Following.Nodes {
+			users = append(users, userFromQL(node))
+		}
+		return users, q.User.Following.PageInfo, nil
+	})
+}
+
+// Organizations returns the organizations the given user is a public member of.
+func (a *Adapter) Organizations(ctx context.Context, username string, count int) ([]domain.User, error) {
+	return paginate(count, nil, func(first int, after *githubv4.String) ([]domain.User, qlPageInfo, error) {
+		var q organizationsQuery
+		variables := map[string]interface{}{
+			"username": githubv4.String(username),
+			"first":    githubv4.Int(first),
+			"after":    after,
+		}
+		if err := a.client.Query(ctx, &q, variables); err != nil {
+			return nil, qlPageInfo{}, err
+		}
+		var orgs []domain.User
+		for _, node := range q.User.Organizations.Nodes {
+			orgs = append(orgs, userFromQL(node))
+		}
+		return orgs, q.User.Organizations.PageInfo, nil
+	})
+}
+
+// RecentPullRequests returns recent pull requests created by the user,
+// optionally restricted to the given states (OPEN, CLOSED, MERGED).
+func (a *Adapter) RecentPullRequests(ctx context.Context, username string, count int, states []string, keep func(domain.Repo) bool) ([]domain.PullRequest, error) {
+	var prStates *[]githubv4.PullRequestState
+	if len(states) > 0 {
+		prStates = &[]githubv4.PullRequestState{}
+		for _, st := range states {
+			*prStates = append(*prStates, githubv4.PullRequestState(strings.ToUpper(st)))
 		}
 	}
-	return prs, nil
+	return paginate(count, keepBy(keep, func(pr domain.PullRequest) domain.Repo { return pr.Repo }), func(first int, after *githubv4.String) ([]domain.PullRequest, qlPageInfo, error) {
+		var q recentPullRequestsQuery
+		variables := map[string]interface{}{
+			"username": githubv4.String(username),
+			"first":    githubv4.Int(first),
+			"after":    after,
+			"states":   prStates,
+		}
+		if err := a.client.Query(ctx, &q, variables); err != nil {
+			return nil, qlPageInfo{}, err
+		}
+		var prs []domain.PullRequest
+		for _, edge := range q.User.PullRequests.Edges {
+			prs = append(prs, pullRequestFromQL(edge.Node))
+		}
+		return prs, q.User.PullRequests.PageInfo, nil
+	})
 }
 
 // RecentReleases returns repositories with their latest non-draft, non-prerelease release.
-func (a *Adapter) RecentReleases(ctx context.Context, username string, count int) ([]domain.Repo, error) {
-	var after *githubv4.String
-	var out []domain.Repo
-	for {
+func (a *Adapter) RecentReleases(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Repo, error) {
+	return paginate(count, keep, func(_ int, after *githubv4.String) ([]domain.Repo, qlPageInfo, error) {
 		var q recentReleasesQuery
 		variables := map[string]interface{}{
 			"username": githubv4.String(username),
-			"after":    after,
+			// most repositories have no release, so always scan full pages
+			"first":   githubv4.Int(maxPageSize),
+			"after":   after,
+			"privacy": a.privacy(),
 		}
 		if err := a.client.Query(ctx, &q, variables); err != nil {
-			return nil, err
-		}
-		if len(q.User.RepositoriesContributedTo.Edges) == 0 {
-			break
+			return nil, qlPageInfo{}, err
 		}
+		var out []domain.Repo
 		for _, edge := range q.User.RepositoriesContributedTo.Edges {
 			r := repoFromQL(edge.Node.qlRepository)
 			// find first valid release (non-draft, non-prerelease) in descending order
@@ -332,170 +1000,364 @@ func (a *Adapter) RecentReleases(ctx context.Context, username string, count int
 			}
 			if !r.LastRelease.PublishedAt.IsZero() {
 				out = append(out, r)
-				if len(out) >= count {
-					continue
-					// don't early return; still need to set cursor for next loop termination
-				}
 			}
-			after = githubv4.NewString(edge.Cursor)
-		}
-		// If we've collected enough and there's no more pages or we prefer to stop, we can break.
-		if len(out) >= count {
-			break
 		}
-	}
-	return out, nil
+		return out, q.User.RepositoriesContributedTo.PageInfo, nil
+	})
 }
 
 // RecentContributions returns commit contributions grouped by repository.
-func (a *Adapter) RecentContributions(ctx context.Context, username string, count int) ([]domain.Contribution, error) {
-	var q recentContributionsQuery
+func (a *Adapter) RecentContributions(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Contribution, error) {
+	meta := fmt.Sprintf("%s/%s", username, username)
+	return paginate(count, keepBy(keep, func(c domain.Contribution) domain.Repo { return c.Repo }), func(first int, after *githubv4.String) ([]domain.Contribution, qlPageInfo, error) {
+		var q recentContributionsQuery
+		variables := map[string]interface{}{
+			"username": githubv4.String(username),
+			"first":    githubv4.Int(first),
+			"after":    after,
+			"privacy":  a.privacy(),
+		}
+		if err := a.client.Query(ctx, &q, variables); err != nil {
+			return nil, qlPageInfo{}, err
+		}
 
-	variables := map[string]interface{}{
-		"username": githubv4.String(username),
-	}
+		var out []domain.Contribution
+		for _, edge := range q.User.Repositories.Edges {
+			repo := edge.Node
 
-	if err := a.client.Query(ctx, &q, variables); err != nil {
-		return nil, err
-	}
+			// Filter Meta-Repo (username/username)
+			if string(repo.NameWithOwner) == meta {
+				continue
+			}
 
-	var out []domain.Contribution
+			out = append(out, domain.Contribution{
+				Repo:       repoFromQL(repo),
+				OccurredAt: repo.PushedAt.Time, // ← Verwendet jetzt PushedAt statt OccurredAt
+			})
+		}
+		return out, q.User.Repositories.PageInfo, nil
+	})
+}
 
-	for _, edge := range q.User.Repositories.Edges {
-		repo := edge.Node
+// commitScanRepos is the number of most recently pushed repositories
+// searched for commits per request by RecentCommits.
+const commitScanRepos = 20
 
-		// Filter Meta-Repo (username/username)
-		if string(repo.NameWithOwner) == fmt.Sprintf("%s/%s", username, username) {
-			continue
-		}
+// RecentCommits returns up to count commits authored by the user on the default
+// branch of their most recently pushed repositories. Repositories are searched
+// commitScanRepos at a time, and the commits of each batch are sorted newest
+// first. Commits rejected by keep are skipped and don't count; further batches
+// are searched until count commits are found.
+func (a *Adapter) RecentCommits(ctx context.Context, username string, count int, keep func(domain.Commit) bool) ([]domain.Commit, error) {
+	var idq userIDQuery
+	if err := a.client.Query(ctx, &idq, map[string]interface{}{
+		"username": githubv4.String(username),
+	}); err != nil {
+		return nil, err
+	}
 
-		c := domain.Contribution{
-			Repo:       repoFromQL(repo),
-			OccurredAt: repo.PushedAt.Time, // ← Verwendet jetzt PushedAt statt OccurredAt
+	return paginate(count, keep, func(first int, after *githubv4.String) ([]domain.Commit, qlPageInfo, error) {
+		var q recentCommitsQuery
+		variables := map[string]interface{}{
+			"username": githubv4.String(username),
+			"repos":    githubv4.Int(commitScanRepos),
+			"after":    after,
+			"first":    githubv4.Int(first),
+			"author":   githubv4.CommitAuthor{ID: &idq.User.ID},
+			"privacy":  a.privacy(),
+		}
+		if err := a.client.Query(ctx, &q, variables); err != nil {
+			return nil, qlPageInfo{}, err
+		}
+		var out []domain.Commit
+		for _, node := range q.User.Repositories.Nodes {
+			repo := repoFromQL(node.qlRepository)
+			for _, c := range node.DefaultBranchRef.Target.Commit.History.Nodes {
+				out = append(out, domain.Commit{
+					Message:     string(c.MessageHeadline),
+					SHA:         string(c.AbbreviatedOid),
+					URL:         string(c.URL),
+					CommittedAt: c.CommittedDate.Time,
+					Repo:        repo,
+				})
+			}
 		}
-		out = append(out, c)
+		sort.SliceStable(out, func(i, j int) bool { return out[i].CommittedAt.After(out[j].CommittedAt) })
+		return out, q.User.Repositories.PageInfo, nil
+	})
+}
 
-		// Limit erreicht?
-		if len(out) >= count {
-			break
+// RecentIssues returns recent issues opened by the user,
+// optionally restricted to the given states (OPEN, CLOSED).
+func (a *Adapter) RecentIssues(ctx context.Context, username string, count int, states []string, keep func(domain.Repo) bool) ([]domain.Issue, error) {
+	var issueStates *[]githubv4.IssueState
+	if len(states) > 0 {
+		issueStates = &[]githubv4.IssueState{}
+		for _, st := range states {
+			*issueStates = append(*issueStates, githubv4.IssueState(strings.ToUpper(st)))
 		}
 	}
+	return paginate(count, keepBy(keep, func(is domain.Issue) domain.Repo { return is.Repo }), func(first int, after *githubv4.String) ([]domain.Issue, qlPageInfo, error) {
+		var q recentIssuesQuery
+		variables := map[string]interface{}{
+			"username": githubv4.String(username),
+			"first":    githubv4.Int(first),
+			"after":    after,
+			"states":   issueStates,
+		}
+		if err := a.client.Query(ctx, &q, variables); err != nil {
+			return nil, qlPageInfo{}, err
+		}
+		var out []domain.Issue
+		for _, node := range q.User.Issues.Nodes {
+			out = append(out, issueFromQL(node))
+		}
+		return out, q.User.Issues.PageInfo, nil
+	})
+}
 
-	return out, nil
+// RecentReviews returns the most recent pull request reviews submitted by the user.
+func (a *Adapter) RecentReviews(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Review, error) {
+	return paginate(count, keepBy(keep, func(r domain.Review) domain.Repo { return r.PullRequest.Repo }), func(first int, after *githubv4.String) ([]domain.Review, qlPageInfo, error) {
+		var q recentReviewsQuery
+		variables := map[string]interface{}{
+			"username": githubv4.String(username),
+			"first":    githubv4.Int(first),
+			"after":    after,
+		}
+		if err := a.client.Query(ctx, &q, variables); err != nil {
+			return nil, qlPageInfo{}, err
+		}
+		contributions := q.User.ContributionsCollection.PullRequestReviewContributions
+		var out []domain.Review
+		for _, node := range contributions.Nodes {
+			out = append(out, domain.Review{
+				PullRequest: pullRequestFromQL(node.PullRequest),
+				State:       string(node.PullRequestReview.State),
+				URL:         string(node.PullRequestReview.URL),
+				OccurredAt:  node.OccurredAt.Time,
+			})
+		}
+		return out, contributions.PageInfo, nil
+	})
 }
 
-// RecentIssues returns recent issue contributions grouped by repository.
-func (a *Adapter) RecentIssues(ctx context.Context, username string, count int) ([]domain.Issue, error) {
-	var q recentIssuesQuery
-	variables := map[string]interface{}{
-		"username": githubv4.String(username),
-	}
-	if err := a.client.Query(ctx, &q, variables); err != nil {
-		return nil, err
-	}
-	var out []domain.Issue
-	for _, v := range q.User.ContributionsCollection.IssueContributionsByRepository {
-		out = append(out, domain.Issue{
-			Repo:       repoFromQL(v.Repository),
-			OccurredAt: v.Contributions.Edges[0].Node.OccurredAt.Time,
-			Title:      string(v.Contributions.Edges[0].Node.Issue.Title),
-		})
-		if len(out) >= count {
-			break
+// RecentDiscussions returns the most recent discussions started by the user.
+func (a *Adapter) RecentDiscussions(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Discussion, error) {
+	return paginate(count, keepBy(keep, discussionRepo), func(first int, after *githubv4.String) ([]domain.Discussion, qlPageInfo, error) {
+		var q recentDiscussionsQuery
+		variables := map[string]interface{}{
+			"username": githubv4.String(username),
+			"first":    githubv4.Int(first),
+			"after":    after,
 		}
-	}
-	return out, nil
+		if err := a.client.Query(ctx, &q, variables); err != nil {
+			return nil, qlPageInfo{}, err
+		}
+		var out []domain.Discussion
+		for _, node := range q.User.RepositoryDiscussions.Nodes {
+			out = append(out, discussionFromQL(node))
+		}
+		return out, q.User.RepositoryDiscussions.PageInfo, nil
+	})
+}
+
+// RecentDiscussionComments returns the most recent discussion comments
+// written by the user, newest first. The connection has no ordering argument
+// and lists the oldest comments first, so it is walked from its end.
+func (a *Adapter) RecentDiscussionComments(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Discussion, error) {
+	return paginateBackward(count, keepBy(keep, discussionRepo), func(last int, before *githubv4.String) ([]domain.Discussion, qlPrevPageInfo, error) {
+		var q recentDiscussionCommentsQuery
+		variables := map[string]interface{}{
+			"username": githubv4.String(username),
+			"last":     githubv4.Int(last),
+			"before":   before,
+		}
+		if err := a.client.Query(ctx, &q, variables); err != nil {
+			return nil, qlPrevPageInfo{}, err
+		}
+		var out []domain.Discussion
+		for _, node := range q.User.RepositoryDiscussionComments.Nodes {
+			d := discussionFromQL(node.Discussion)
+			d.URL = string(node.URL)
+			d.CreatedAt = node.CreatedAt.Time
+			out = append(out, d)
+		}
+		return out, q.User.RepositoryDiscussionComments.PageInfo, nil
+	})
 }
 
 // Sponsors returns recent sponsors (users and organizations) for the maintainer.
 func (a *Adapter) Sponsors(ctx context.Context, username string, count int) ([]domain.Sponsor, error) {
-	var q sponsorsQuery
+	return paginate(count, nil, func(first int, after *githubv4.String) ([]domain.Sponsor, qlPageInfo, error) {
+		var q sponsorsQuery
+		variables := map[string]interface{}{
+			"username": githubv4.String(username),
+			"first":    githubv4.Int(first),
+			"after":    after,
+		}
+		if err := a.client.Query(ctx, &q, variables); err != nil {
+			return nil, qlPageInfo{}, err
+		}
+		var out []domain.Sponsor
+		for _, edge := range q.User.SponsorshipsAsMaintainer.Edges {
+			se := edge.Node.SponsorEntity
+			var u domain.User
+			switch string(se.Typename) {
+			case "User":
+				u = userFromQL(se.User)
+			case "Organization":
+				u = userFromQL(se.Organization)
+			default:
+				continue
+			}
+			out = append(out, domain.Sponsor{
+				User:             u,
+				CreatedAt:        edge.Node.CreatedAt.Time,
+				IsOneTimePayment: bool(edge.Node.IsOneTimePayment),
+				Tier: domain.SponsorTier{
+					Name:                  string(edge.Node.Tier.Name),
+					MonthlyPriceInDollars: int(edge.Node.Tier.MonthlyPriceInDollars),
+				},
+			})
+		}
+		return out, q.User.SponsorshipsAsMaintainer.PageInfo, nil
+	})
+}
+
+// SponsorsGoal returns the active goal of the user's sponsors listing.
+// The zero value is returned if there is no listing or no active goal.
+func (a *Adapter) SponsorsGoal(ctx context.Context, username string) (domain.SponsorsGoal, error) {
+	var q sponsorsGoalQuery
 	variables := map[string]interface{}{
 		"username": githubv4.String(username),
-		"count":    githubv4.Int(count),
 	}
 	if err := a.client.Query(ctx, &q, variables); err != nil {
-		return nil, err
+		return domain.SponsorsGoal{}, err
 	}
-	var out []domain.Sponsor
-	for _, edge := range q.User.SponsorshipsAsMaintainer.Edges {
-		se := edge.Node.SponsorEntity
-		var u domain.User
-		switch string(se.Typename) {
-		case "User":
-			u = userFromQL(se.User)
-		case "Organization":
-			u = userFromQL(se.Organization)
-		default:
-			continue
+	g := q.User.SponsorsListing.ActiveGoal
+	return domain.SponsorsGoal{
+		Kind:            string(g.Kind),
+		Title:           string(g.Title),
+		Description:     string(g.Description),
+		PercentComplete: int(g.PercentComplete),
+		TargetValue:     int(g.TargetValue),
+	}, nil
+}
+
+// Sponsoring returns the users and organizations the user sponsors, ordered by login.
+func (a *Adapter) Sponsoring(ctx context.Context, username string, count int) ([]domain.User, error) {
+	return paginate(count, nil, func(first int, after *githubv4.String) ([]domain.User, qlPageInfo, error) {
+		var q sponsoringQuery
+		variables := map[string]interface{}{
+			"username": githubv4.String(username),
+			"first":    githubv4.Int(first),
+			"after":    after,
 		}
-		out = append(out, domain.Sponsor{User: u, CreatedAt: edge.Node.CreatedAt.Time})
-		if len(out) >= count {
-			break
+		if err := a.client.Query(ctx, &q, variables); err != nil {
+			return nil, qlPageInfo{}, err
 		}
-	}
-	return out, nil
+		var out []domain.User
+		for _, node := range q.User.Sponsoring.Nodes {
+			switch string(node.Typename) {
+			case "User":
+				out = append(out, userFromQL(node.User))
+			case "Organization":
+				out = append(out, userFromQL(node.Organization))
+			}
+		}
+		return out, q.User.Sponsoring.PageInfo, nil
+	})
 }
 
 // Gists returns user's gists ordered by creation date desc limited by count.
 func (a *Adapter) Gists(ctx context.Context, username string, count int) ([]domain.Gist, error) {
-	var q gistsQuery
-	variables := map[string]interface{}{
-		"username": githubv4.String(username),
-		"count":    githubv4.Int(count),
-	}
-	if err := a.client.Query(ctx, &q, variables); err != nil {
-		return nil, err
-	}
-	var out []domain.Gist
-	for _, edge := range q.User.Gists.Edges {
-		g := edge.Node
-		out = append(out, domain.Gist{
-			Name:        string(g.Name),
-			Description: string(g.Description),
-			URL:         string(g.URL),
-			CreatedAt:   g.CreatedAt.Time,
-		})
-	}
-	return out, nil
+	return paginate(count, nil, func(first int, after *githubv4.String) ([]domain.Gist, qlPageInfo, error) {
+		var q gistsQuery
+		variables := map[string]interface{}{
+			"username": githubv4.String(username),
+			"first":    githubv4.Int(first),
+			"after":    after,
+		}
+		if err := a.client.Query(ctx, &q, variables); err != nil {
+			return nil, qlPageInfo{}, err
+		}
+		var out []domain.Gist
+		for _, edge := range q.User.Gists.Edges {
+			out = append(out, gistFromQL(edge.Node))
+		}
+		return out, q.User.Gists.PageInfo, nil
+	})
 }
 
-// RecentStars returns recently starred public repositories by the user.
-func (a *Adapter) RecentStars(ctx context.Context, username string, count int) ([]domain.Star, error) {
-	var q recentStarsQuery
-	var after *githubv4.String
-	var out []domain.Star
-outer:
-	for {
+// RecentStars returns recently starred repositories by the user.
+func (a *Adapter) RecentStars(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Star, error) {
+	return paginate(count, keepBy(keep, func(st domain.Star) domain.Repo { return st.Repo }), func(first int, after *githubv4.String) ([]domain.Star, qlPageInfo, error) {
+		var q recentStarsQuery
 		variables := map[string]interface{}{
 			"username": githubv4.String(username),
-			"count":    githubv4.Int(count),
+			"first":    githubv4.Int(first),
 			"after":    after,
 		}
 		if err := a.client.Query(ctx, &q, variables); err != nil {
-			return nil, err
-		}
-		if len(q.User.Stars.Edges) == 0 {
-			break outer
+			return nil, qlPageInfo{}, err
 		}
+		var out []domain.Star
 		for _, edge := range q.User.Stars.Edges {
-			repo := edge.Node
-			if bool(repo.IsPrivate) {
+			// starredRepositories has no privacy argument
+			if a.publicOnly && bool(edge.Node.IsPrivate) {
 				continue
 			}
 			out = append(out, domain.Star{
 				StarredAt: edge.StarredAt.Time,
-				Repo:      repoFromQL(repo),
+				Repo:      repoFromQL(edge.Node),
 			})
-			if len(out) == count {
-				break outer
-			}
-			after = githubv4.NewString(edge.Cursor)
 		}
+		return out, q.User.Stars.PageInfo, nil
+	})
+}
+
+// PinnedRepos returns the repositories pinned to the user's profile, in pinned order.
+func (a *Adapter) PinnedRepos(ctx context.Context, username string) ([]domain.Repo, error) {
+	q, err := a.pinnedItems(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	var out []domain.Repo
+	for _, node := range q.User.PinnedItems.Nodes {
+		if string(node.Typename) != "Repository" {
+			continue
+		}
+		out = append(out, repoFromQL(node.Repository))
+	}
+	return out, nil
+}
+
+// PinnedGists returns the gists pinned to the user's profile, in pinned order.
+func (a *Adapter) PinnedGists(ctx context.Context, username string) ([]domain.Gist, error) {
+	q, err := a.pinnedItems(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	var out []domain.Gist
+	for _, node := range q.User.PinnedItems.Nodes {
+		if string(node.Typename) != "Gist" {
+			continue
+		}
+		out = append(out, gistFromQL(node.Gist))
 	}
 	return out, nil
 }
 
+func (a *Adapter) pinnedItems(ctx context.Context, username string) (pinnedItemsQuery, error) {
+	var q pinnedItemsQuery
+	variables := map[string]interface{}{
+		"username": githubv4.String(username),
+	}
+	err := a.client.Query(ctx, &q, variables)
+	return q, err
+}
+
 // local helpers to map GraphQL to domain
 func repoFromQL(repo qlRepository) domain.Repo {
 	var lastRelease domain.Release
@@ -509,14 +1371,46 @@ func repoFromQL(repo qlRepository) domain.Repo {
 		}
 	}
 
+	var topics []string
+	for _, n := range repo.RepositoryTopics.Nodes {
+		topics = append(topics, string(n.Topic.Name))
+	}
+
 	return domain.Repo{
 		Name:        string(repo.NameWithOwner),
 		URL:         string(repo.URL),
+		MirrorURL:   string(repo.MirrorURL),
 		Description: string(repo.Description),
 		Stargazers:  int(repo.Stargazers.TotalCount),
 		IsPrivate:   bool(repo.IsPrivate),
+		IsArchived:  bool(repo.IsArchived),
+		IsFork:      bool(repo.IsFork),
+		Topics:      topics,
 		LastRelease: lastRelease,
+		CreatedAt:   repo.CreatedAt.Time,
+		PushedAt:    repo.PushedAt.Time,
+	}
+}
+
+func releaseFromQL(r qlReleaseDetails) domain.Release {
+	rel := domain.Release{
+		Name:         string(r.Name),
+		TagName:      string(r.TagName),
+		PublishedAt:  r.PublishedAt.Time,
+		URL:          string(r.URL),
+		IsPrerelease: bool(r.IsPrerelease),
+		Description:  string(r.Description),
+	}
+	for _, a := range r.ReleaseAssets.Nodes {
+		rel.Assets = append(rel.Assets, domain.ReleaseAsset{
+			Name:          string(a.Name),
+			URL:           string(a.DownloadURL),
+			Size:          int(a.Size),
+			DownloadCount: int(a.DownloadCount),
+		})
+		rel.Downloads += int(a.DownloadCount)
 	}
+	return rel
 }
 
 func userFromQL(user qlUser) domain.User {
@@ -527,3 +1421,60 @@ func userFromQL(user qlUser) domain.User {
 		URL:       string(user.URL),
 	}
 }
+
+func pullRequestFromQL(pr qlPullRequest) domain.PullRequest {
+	return domain.PullRequest{
+		Title:     string(pr.Title),
+		URL:       string(pr.URL),
+		State:     string(pr.State),
+		CreatedAt: pr.CreatedAt.Time,
+		Repo:      repoFromQL(pr.Repository),
+		Number:    int(pr.Number),
+		MergedAt:  pr.MergedAt.Time,
+		Additions: int(pr.Additions),
+		Deletions: int(pr.Deletions),
+		Labels:    labelsFromQL(pr.Labels),
+	}
+}
+
+func issueFromQL(issue qlIssue) domain.Issue {
+	return domain.Issue{
+		Repo:       repoFromQL(issue.Repository),
+		OccurredAt: issue.CreatedAt.Time,
+		Title:      string(issue.Title),
+		Number:     int(issue.Number),
+		URL:        string(issue.URL),
+		State:      string(issue.State),
+		Labels:     labelsFromQL(issue.Labels),
+	}
+}
+
+func labelsFromQL(labels qlLabels) []string {
+	var out []string
+	for _, l := range labels.Nodes {
+		out = append(out, string(l.Name))
+	}
+	return out
+}
+
+func discussionRepo(d domain.Discussion) domain.Repo { return d.Repo }
+
+func discussionFromQL(d qlDiscussion) domain.Discussion {
+	return domain.Discussion{
+		Title:      string(d.Title),
+		URL:        string(d.URL),
+		Category:   string(d.Category.Name),
+		IsAnswered: bool(d.IsAnswered),
+		CreatedAt:  d.CreatedAt.Time,
+		Repo:       repoFromQL(d.Repository),
+	}
+}
+
+func gistFromQL(gist qlGist) domain.Gist {
+	return domain.Gist{
+		Name:        string(gist.Name),
+		Description: string(gist.Description),
+		URL:         string(gist.URL),
+		CreatedAt:   gist.CreatedAt.Time,
+	}
+}
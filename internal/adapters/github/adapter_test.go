@@ -0,0 +1,161 @@
+package githubadapter
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+// graphQLRequest is a GraphQL request as received by a fake server.
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+// fakeGraphQL serves each request with reply and records the requests.
+func fakeGraphQL(t *testing.T, reply func(req graphQLRequest) string) (*githubv4.Client, *[]graphQLRequest) {
+	t.Helper()
+	var requests []graphQLRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		requests = append(requests, req)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":` + reply(req) + `}`))
+	}))
+	t.Cleanup(srv.Close)
+	return githubv4.NewEnterpriseClient(srv.URL, srv.Client()), &requests
+}
+
+func TestAdapter_RecentReposPrivacy(t *testing.T) {
+	tests := []struct {
+		name            string
+		opts            []Option
+		expectedPrivacy interface{}
+	}{
+		{name: "all repositories by default", expectedPrivacy: nil},
+		{name: "public repositories only", opts: []Option{WithPublicOnly()}, expectedPrivacy: "PUBLIC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, requests := fakeGraphQL(t, func(graphQLRequest) string {
+				return `{"user":{"login":"me","repositories":{"pageInfo":{"hasNextPage":false},"edges":[
+					{"node":{"nameWithOwner":"me/app"}}
+				]}}}`
+			})
+
+			repos, err := New(client, tt.opts...).RecentRepos(context.Background(), "me", 5, false, nil)
+
+			require.NoError(t, err)
+			assert.Len(t, repos, 1)
+			require.Len(t, *requests, 1)
+			req := (*requests)[0]
+			assert.Contains(t, req.Query, "$privacy:RepositoryPrivacy")
+			assert.Equal(t, tt.expectedPrivacy, req.Variables["privacy"])
+		})
+	}
+}
+
+func TestAdapter_RecentReposKeep(t *testing.T) {
+	client, requests := fakeGraphQL(t, func(req graphQLRequest) string {
+		if req.Variables["after"] == nil {
+			return `{"user":{"login":"me","repositories":{"pageInfo":{"hasNextPage":true,"endCursor":"p1"},"edges":[
+				{"node":{"nameWithOwner":"me/me"}},
+				{"node":{"nameWithOwner":"me/app"}},
+				{"node":{"nameWithOwner":"me/internal-a"}}
+			]}}}`
+		}
+		return `{"user":{"login":"me","repositories":{"pageInfo":{"hasNextPage":false},"edges":[
+			{"node":{"nameWithOwner":"me/lib"}},
+			{"node":{"nameWithOwner":"me/tool"}}
+		]}}}`
+	})
+	keep := func(r domain.Repo) bool {
+		return r.Name != "me/me" && !strings.HasPrefix(r.Name, "me/internal-")
+	}
+
+	repos, err := New(client).RecentRepos(context.Background(), "me", 3, false, keep)
+
+	require.NoError(t, err)
+	var names []string
+	for _, r := range repos {
+		names = append(names, r.Name)
+	}
+	assert.Equal(t, []string{"me/app", "me/lib", "me/tool"}, names)
+	assert.Len(t, *requests, 2)
+}
+
+func TestAdapter_RecentDiscussionComments(t *testing.T) {
+	client, requests := fakeGraphQL(t, func(req graphQLRequest) string {
+		if req.Variables["before"] == nil {
+			return `{"user":{"login":"me","repositoryDiscussionComments":{"pageInfo":{"hasPreviousPage":true,"startCursor":"c2"},"nodes":[
+				{"url":"https://github.com/o/r/discussions/1#c2","createdAt":"2025-01-02T00:00:00Z","discussion":{"title":"B"}},
+				{"url":"https://github.com/o/r/discussions/1#c3","createdAt":"2025-01-03T00:00:00Z","discussion":{"title":"C"}}
+			]}}}`
+		}
+		return `{"user":{"login":"me","repositoryDiscussionComments":{"pageInfo":{"hasPreviousPage":false},"nodes":[
+			{"url":"https://github.com/o/r/discussions/1#c1","createdAt":"2025-01-01T00:00:00Z","discussion":{"title":"A"}}
+		]}}}`
+	})
+
+	comments, err := New(client).RecentDiscussionComments(context.Background(), "me", 3, nil)
+
+	require.NoError(t, err)
+	var titles []string
+	for _, c := range comments {
+		titles = append(titles, c.Title)
+	}
+	assert.Equal(t, []string{"C", "B", "A"}, titles)
+	require.Len(t, *requests, 2)
+	assert.Contains(t, (*requests)[0].Query, "repositoryDiscussionComments(last: $last, before: $before)")
+	assert.Equal(t, "c2", (*requests)[1].Variables["before"])
+}
+
+func TestAdapter_RecentCommitsKeep(t *testing.T) {
+	client, requests := fakeGraphQL(t, func(req graphQLRequest) string {
+		switch {
+		case req.Variables["repos"] == nil:
+			return `{"user":{"id":"U1"}}`
+		case req.Variables["after"] == nil:
+			return `{"user":{"login":"me","repositories":{"pageInfo":{"hasNextPage":true,"endCursor":"r1"},"nodes":[
+				{"nameWithOwner":"me/old","defaultBranchRef":{"target":{"history":{"nodes":[
+					{"abbreviatedOid":"o1","committedDate":"2025-01-01T00:00:00Z"}
+				]}}}},
+				{"nameWithOwner":"me/internal","defaultBranchRef":{"target":{"history":{"nodes":[
+					{"abbreviatedOid":"i1","committedDate":"2025-01-05T00:00:00Z"}
+				]}}}},
+				{"nameWithOwner":"me/app","defaultBranchRef":{"target":{"history":{"nodes":[
+					{"abbreviatedOid":"a1","committedDate":"2025-01-03T00:00:00Z"}
+				]}}}}
+			]}}}`
+		default:
+			return `{"user":{"login":"me","repositories":{"pageInfo":{"hasNextPage":false},"nodes":[
+				{"nameWithOwner":"me/lib","defaultBranchRef":{"target":{"history":{"nodes":[
+					{"abbreviatedOid":"l1","committedDate":"2024-12-01T00:00:00Z"}
+				]}}}}
+			]}}}`
+		}
+	})
+	keep := func(c domain.Commit) bool { return c.Repo.Name != "me/internal" }
+
+	commits, err := New(client).RecentCommits(context.Background(), "me", 3, keep)
+
+	require.NoError(t, err)
+	var shas []string
+	for _, c := range commits {
+		shas = append(shas, c.SHA)
+	}
+	assert.Equal(t, []string{"a1", "o1", "l1"}, shas)
+	require.Len(t, *requests, 3)
+	assert.Equal(t, float64(commitScanRepos), (*requests)[1].Variables["repos"])
+	assert.Equal(t, "r1", (*requests)[2].Variables["after"])
+}
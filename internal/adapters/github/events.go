@@ -0,0 +1,164 @@
+package githubadapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+// eventsPages is the number of pages of 100 events GitHub serves at most;
+// the public events feed is limited to 300 events from the last 90 days.
+const eventsPages = 3
+
+type restEvent struct {
+	Type string `json:"type"`
+	Repo struct {
+		Name string `json:"name"`
+	} `json:"repo"`
+	CreatedAt time.Time       `json:"created_at"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+type restEventPayload struct {
+	Action  string `json:"action"`
+	Ref     string `json:"ref"`
+	RefType string `json:"ref_type"`
+	Head    string `json:"head"`
+	Size    int    `json:"size"`
+	Release struct {
+		Name    string `json:"name"`
+		TagName string `json:"tag_name"`
+		HTMLURL string `json:"html_url"`
+	} `json:"release"`
+	Forkee struct {
+		FullName string `json:"full_name"`
+		HTMLURL  string `json:"html_url"`
+	} `json:"forkee"`
+	Issue struct {
+		Number  int    `json:"number"`
+		Title   string `json:"title"`
+		HTMLURL string `json:"html_url"`
+	} `json:"issue"`
+	PullRequest struct {
+		Number  int    `json:"number"`
+		Title   string `json:"title"`
+		HTMLURL string `json:"html_url"`
+		Merged  bool   `json:"merged"`
+	} `json:"pull_request"`
+}
+
+// Activity returns the user's most recent public events, newest first.
+// Only push, create, release, watch, fork, issue and pull request events are
+// returned; other event types and events in repositories rejected by keep are
+// skipped. A nil keep keeps all repositories.
+func (a *Adapter) Activity(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Activity, error) {
+	var out []domain.Activity
+	for page := 1; page <= eventsPages && len(out) < count; page++ {
+		var events []restEvent
+		query := url.Values{"per_page": {"100"}, "page": {strconv.Itoa(page)}}
+		if err := a.restGet(ctx, "/users/"+url.PathEscape(username)+"/events/public", query, &events); err != nil {
+			return nil, err
+		}
+		for _, e := range events {
+			act, ok, err := a.activityFromEvent(e)
+			if err != nil {
+				return nil, err
+			}
+			if !ok || (keep != nil && !keep(act.Repo)) {
+				continue
+			}
+			out = append(out, act)
+			if len(out) == count {
+				break
+			}
+		}
+		if len(events) < 100 {
+			break
+		}
+	}
+	return out, nil
+}
+
+// activityFromEvent normalizes an event. It reports false for unsupported
+// event types and actions.
+func (a *Adapter) activityFromEvent(e restEvent) (domain.Activity, bool, error) {
+	var p restEventPayload
+	if len(e.Payload) > 0 {
+		if err := json.Unmarshal(e.Payload, &p); err != nil {
+			return domain.Activity{}, false, fmt.Errorf("can't decode %s payload: %w", e.Type, err)
+		}
+	}
+
+	repoURL := a.webURL(e.Repo.Name)
+	act := domain.Activity{
+		Type:      e.Type,
+		Repo:      domain.Repo{Name: e.Repo.Name, URL: repoURL},
+		URL:       repoURL,
+		CreatedAt: e.CreatedAt,
+	}
+
+	switch e.Type {
+	case "PushEvent":
+		branch := strings.TrimPrefix(p.Ref, "refs/heads/")
+		act.Verb = "pushed"
+		act.Object = fmt.Sprintf("to %s", branch)
+		if p.Size > 0 {
+			act.Object = fmt.Sprintf("%d commit%s to %s", p.Size, plural(p.Size), branch)
+		}
+		if p.Head != "" {
+			act.URL = repoURL + "/commit/" + p.Head
+		}
+	case "CreateEvent":
+		act.Verb = "created"
+		act.Object = p.RefType
+		if p.RefType != "repository" {
+			act.Object = p.RefType + " " + p.Ref
+			act.URL = repoURL + "/tree/" + p.Ref
+		}
+	case "ReleaseEvent":
+		if p.Action != "published" {
+			return domain.Activity{}, false, nil
+		}
+		name := p.Release.Name
+		if name == "" {
+			name = p.Release.TagName
+		}
+		act.Verb = "published"
+		act.Object = "release " + name
+		act.URL = p.Release.HTMLURL
+	case "WatchEvent":
+		act.Verb = "starred"
+		act.Object = e.Repo.Name
+	case "ForkEvent":
+		act.Verb = "forked"
+		act.Object = p.Forkee.FullName
+		act.URL = p.Forkee.HTMLURL
+	case "IssuesEvent":
+		act.Verb = p.Action
+		act.Object = fmt.Sprintf("issue #%d: %s", p.Issue.Number, p.Issue.Title)
+		act.URL = p.Issue.HTMLURL
+	case "PullRequestEvent":
+		act.Verb = p.Action
+		if p.Action == "closed" && p.PullRequest.Merged {
+			act.Verb = "merged"
+		}
+		act.Object = fmt.Sprintf("pull request #%d: %s", p.PullRequest.Number, p.PullRequest.Title)
+		act.URL = p.PullRequest.HTMLURL
+	default:
+		return domain.Activity{}, false, nil
+	}
+	return act, true, nil
+}
+
+func plural(n int) string {
+	if n == 1 {
+		return ""
+	}
+	return "s"
+}
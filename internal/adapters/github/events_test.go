@@ -0,0 +1,104 @@
+package githubadapter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+const eventsFixture = `[
+  {"type": "PushEvent", "repo": {"name": "me/app"}, "created_at": "2025-01-07T10:00:00Z",
+   "payload": {"ref": "refs/heads/main", "head": "abc123", "size": 3}},
+  {"type": "GollumEvent", "repo": {"name": "me/app"}, "created_at": "2025-01-06T10:00:00Z", "payload": {}},
+  {"type": "PullRequestEvent", "repo": {"name": "oss/lib"}, "created_at": "2025-01-05T10:00:00Z",
+   "payload": {"action": "closed", "pull_request": {"number": 7, "title": "Fix race", "html_url": "https://github.com/oss/lib/pull/7", "merged": true}}},
+  {"type": "ReleaseEvent", "repo": {"name": "me/app"}, "created_at": "2025-01-04T10:00:00Z",
+   "payload": {"action": "published", "release": {"tag_name": "v1.2.0", "html_url": "https://github.com/me/app/releases/tag/v1.2.0"}}},
+  {"type": "CreateEvent", "repo": {"name": "me/app"}, "created_at": "2025-01-03T10:00:00Z",
+   "payload": {"ref": "feature", "ref_type": "branch"}},
+  {"type": "WatchEvent", "repo": {"name": "oss/lib"}, "created_at": "2025-01-02T10:00:00Z", "payload": {"action": "started"}}
+]`
+
+func TestAdapter_Activity(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v3/users/me/events/public", r.URL.Path)
+		assert.Equal(t, "1", r.URL.Query().Get("page"))
+		fmt.Fprint(w, eventsFixture)
+	}))
+	defer srv.Close()
+
+	a := New(nil, WithREST(srv.Client(), srv.URL+"/api/v3"))
+
+	result, err := a.Activity(context.Background(), "me", 4, nil)
+	require.NoError(t, err)
+
+	web := srv.URL
+	assert.Equal(t, []domain.Activity{
+		{
+			Type: "PushEvent", Verb: "pushed", Object: "3 commits to main",
+			Repo: domain.Repo{Name: "me/app", URL: web + "/me/app"}, URL: web + "/me/app/commit/abc123",
+			CreatedAt: time.Date(2025, 1, 7, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			Type: "PullRequestEvent", Verb: "merged", Object: "pull request #7: Fix race",
+			Repo: domain.Repo{Name: "oss/lib", URL: web + "/oss/lib"}, URL: "https://github.com/oss/lib/pull/7",
+			CreatedAt: time.Date(2025, 1, 5, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			Type: "ReleaseEvent", Verb: "published", Object: "release v1.2.0",
+			Repo: domain.Repo{Name: "me/app", URL: web + "/me/app"}, URL: "https://github.com/me/app/releases/tag/v1.2.0",
+			CreatedAt: time.Date(2025, 1, 4, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			Type: "CreateEvent", Verb: "created", Object: "branch feature",
+			Repo: domain.Repo{Name: "me/app", URL: web + "/me/app"}, URL: web + "/me/app/tree/feature",
+			CreatedAt: time.Date(2025, 1, 3, 10, 0, 0, 0, time.UTC),
+		},
+	}, result)
+}
+
+func TestAdapter_ActivityKeep(t *testing.T) {
+	var pages []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		if page == "1" {
+			// a full page of events in a rejected repository
+			events := make([]string, 100)
+			for i := range events {
+				events[i] = `{"type": "WatchEvent", "repo": {"name": "me/internal"}, "created_at": "2025-01-08T10:00:00Z"}`
+			}
+			fmt.Fprint(w, "["+strings.Join(events, ",")+"]")
+			return
+		}
+		fmt.Fprint(w, eventsFixture)
+	}))
+	defer srv.Close()
+	keep := func(r domain.Repo) bool { return r.Name != "me/internal" }
+
+	result, err := New(nil, WithREST(srv.Client(), srv.URL)).Activity(context.Background(), "me", 2, keep)
+
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "PushEvent", result[0].Type)
+	assert.Equal(t, "PullRequestEvent", result[1].Type)
+	assert.Equal(t, []string{"1", "2"}, pages)
+}
+
+func TestAdapter_ActivityError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "not found", http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	_, err := New(nil, WithREST(srv.Client(), srv.URL)).Activity(context.Background(), "ghost", 5, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,107 @@
+package githubadapter
+
+import (
+	"github.com/shurcooL/githubv4"
+
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+// maxPageSize is the largest page GitHub serves for a single connection request.
+const maxPageSize = 100
+
+// qlPageInfo is the subset of a connection's pageInfo needed to walk it.
+type qlPageInfo struct {
+	HasNextPage githubv4.Boolean
+	EndCursor   githubv4.String
+}
+
+// pageFetcher requests one page of a connection with the given page size,
+// continuing after the given cursor (nil for the first page). It returns the
+// page's items, already mapped to domain types and filtered, plus its pageInfo.
+type pageFetcher[T any] func(first int, after *githubv4.String) ([]T, qlPageInfo, error)
+
+// paginate walks a connection page by page until count items have been
+// collected or the connection has no further pages. Items rejected by keep
+// are skipped and don't count; a nil keep keeps all items. It never returns
+// more than count items.
+func paginate[T any](count int, keep func(T) bool, fetch pageFetcher[T]) ([]T, error) {
+	var out []T
+	var after *githubv4.String
+	for len(out) < count {
+		size := count - len(out)
+		if keep != nil {
+			// rejected items leave gaps, so don't shrink the pages
+			size = count
+		}
+		items, page, err := fetch(min(size, maxPageSize), after)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			if keep != nil && !keep(item) {
+				continue
+			}
+			out = append(out, item)
+			if len(out) == count {
+				return out, nil
+			}
+		}
+		if !bool(page.HasNextPage) {
+			break
+		}
+		after = githubv4.NewString(page.EndCursor)
+	}
+	return out, nil
+}
+
+// qlPrevPageInfo is the subset of a connection's pageInfo needed to walk it
+// backwards.
+type qlPrevPageInfo struct {
+	HasPreviousPage githubv4.Boolean
+	StartCursor     githubv4.String
+}
+
+// prevPageFetcher requests the page of a connection that ends before the
+// given cursor (nil for the last page), with items in connection order.
+type prevPageFetcher[T any] func(last int, before *githubv4.String) ([]T, qlPrevPageInfo, error)
+
+// paginateBackward is like paginate, but walks the connection from its end,
+// for connections without an ordering argument that list the oldest items
+// first. Items are returned newest first.
+func paginateBackward[T any](count int, keep func(T) bool, fetch prevPageFetcher[T]) ([]T, error) {
+	var out []T
+	var before *githubv4.String
+	for len(out) < count {
+		size := count - len(out)
+		if keep != nil {
+			size = count
+		}
+		items, page, err := fetch(min(size, maxPageSize), before)
+		if err != nil {
+			return nil, err
+		}
+		for i := len(items) - 1; i >= 0; i-- {
+			if keep != nil && !keep(items[i]) {
+				continue
+			}
+			out = append(out, items[i])
+			if len(out) == count {
+				return out, nil
+			}
+		}
+		if !bool(page.HasPreviousPage) {
+			break
+		}
+		before = githubv4.NewString(page.StartCursor)
+	}
+	return out, nil
+}
+
+// keepBy adapts a repository filter to items of type T, using repo to get an
+// item's repository. A nil keep stays nil.
+func keepBy[T any](keep func(domain.Repo) bool, repo func(T) domain.Repo) func(T) bool {
+	if keep == nil {
+		return nil
+	}
+	return func(item T) bool { return keep(repo(item)) }
+}
@@ -0,0 +1,151 @@
+package githubadapter
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeConnection serves pages of consecutive ints, using the offset as cursor,
+// and records the page size of every request.
+type fakeConnection struct {
+	total    int
+	requests []int
+}
+
+func (f *fakeConnection) fetch(first int, after *githubv4.String) ([]int, qlPageInfo, error) {
+	f.requests = append(f.requests, first)
+	start := 0
+	if after != nil {
+		start, _ = strconv.Atoi(string(*after))
+	}
+	var items []int
+	for i := start; i < start+first && i < f.total; i++ {
+		items = append(items, i)
+	}
+	end := start + len(items)
+	return items, qlPageInfo{
+		HasNextPage: githubv4.Boolean(end < f.total),
+		EndCursor:   githubv4.String(strconv.Itoa(end)),
+	}, nil
+}
+
+func TestPaginate(t *testing.T) {
+	tests := []struct {
+		name             string
+		total            int
+		count            int
+		expectedLen      int
+		expectedRequests []int
+	}{
+		{
+			name:             "single page",
+			total:            500,
+			count:            10,
+			expectedLen:      10,
+			expectedRequests: []int{10},
+		},
+		{
+			name:             "stops exactly at count across pages",
+			total:            500,
+			count:            150,
+			expectedLen:      150,
+			expectedRequests: []int{100, 50},
+		},
+		{
+			name:             "stops when connection is exhausted",
+			total:            120,
+			count:            300,
+			expectedLen:      120,
+			expectedRequests: []int{100, 100},
+		},
+		{
+			name:             "zero count makes no request",
+			total:            10,
+			count:            0,
+			expectedLen:      0,
+			expectedRequests: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &fakeConnection{total: tt.total}
+
+			result, err := paginate(tt.count, nil, conn.fetch)
+
+			assert.NoError(t, err)
+			assert.Len(t, result, tt.expectedLen)
+			assert.Equal(t, tt.expectedRequests, conn.requests)
+			for i, v := range result {
+				assert.Equal(t, i, v)
+			}
+		})
+	}
+}
+
+func TestPaginate_FilteredPagesKeepGoing(t *testing.T) {
+	pages := [][]int{{1}, {}, {2, 3, 4}}
+	calls := 0
+
+	result, err := paginate(3, nil, func(first int, after *githubv4.String) ([]int, qlPageInfo, error) {
+		page := pages[calls]
+		calls++
+		return page, qlPageInfo{HasNextPage: githubv4.Boolean(calls < len(pages)), EndCursor: "c"}, nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, result)
+	assert.Equal(t, 3, calls)
+}
+
+func TestPaginate_KeepsPagingPastRejectedItems(t *testing.T) {
+	conn := &fakeConnection{total: 20}
+	even := func(i int) bool { return i%2 == 0 }
+
+	result, err := paginate(4, even, conn.fetch)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 2, 4, 6}, result)
+	assert.Equal(t, []int{4, 4}, conn.requests)
+}
+
+func TestPaginateBackward(t *testing.T) {
+	// 0..9 in connection order, oldest first
+	var before []string
+	fetch := func(last int, cursor *githubv4.String) ([]int, qlPrevPageInfo, error) {
+		end := 10
+		if cursor != nil {
+			before = append(before, string(*cursor))
+			end, _ = strconv.Atoi(string(*cursor))
+		}
+		start := max(end-last, 0)
+		var items []int
+		for i := start; i < end; i++ {
+			items = append(items, i)
+		}
+		return items, qlPrevPageInfo{
+			HasPreviousPage: githubv4.Boolean(start > 0),
+			StartCursor:     githubv4.String(strconv.Itoa(start)),
+		}, nil
+	}
+	odd := func(i int) bool { return i%2 == 1 }
+
+	result, err := paginateBackward(4, odd, fetch)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{9, 7, 5, 3}, result)
+	assert.Equal(t, []string{"6"}, before)
+}
+
+func TestPaginate_ReturnsError(t *testing.T) {
+	result, err := paginate(10, nil, func(first int, after *githubv4.String) ([]int, qlPageInfo, error) {
+		return nil, qlPageInfo{}, errors.New("api error")
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+}
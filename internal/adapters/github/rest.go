@@ -0,0 +1,67 @@
+package githubadapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// DefaultRESTURL is the REST API root of github.com.
+const DefaultRESTURL = "https://api.github.com"
+
+// Option configures an Adapter.
+type Option func(*Adapter)
+
+// WithREST sets the HTTP client and API root used for features that are only
+// available through the REST API. The client is expected to authenticate its
+// requests, e.g. via oauth2.NewClient.
+func WithREST(client *http.Client, baseURL string) Option {
+	return func(a *Adapter) {
+		if client != nil {
+			a.rest = client
+		}
+		if baseURL != "" {
+			a.restURL = strings.TrimRight(baseURL, "/")
+		}
+	}
+}
+
+// restGet fetches path relative to the REST API root and decodes the JSON
+// response into out.
+func (a *Adapter) restGet(ctx context.Context, path string, query url.Values, out interface{}) error {
+	endpoint := a.restURL + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+
+	resp, err := a.rest.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("GET %s: %s", path, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// webURL returns the web URL for a path like "owner/name", derived from the
+// REST API root: api.github.com maps to github.com, Enterprise Server's
+// https://host/api/v3 to https://host.
+func (a *Adapter) webURL(path string) string {
+	base := "https://github.com"
+	if a.restURL != DefaultRESTURL {
+		base = strings.TrimSuffix(a.restURL, "/api/v3")
+	}
+	return base + "/" + path
+}
@@ -0,0 +1,94 @@
+package githubadapter
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"time"
+
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+type restTrafficCount struct {
+	Timestamp time.Time `json:"timestamp"`
+	Count     int       `json:"count"`
+	Uniques   int       `json:"uniques"`
+}
+
+// Traffic returns the view and clone statistics of the last 14 days together
+// with the top referrers and popular paths. It requires push access.
+func (a *Adapter) Traffic(ctx context.Context, owner, name string) (domain.Traffic, error) {
+	base := fmt.Sprintf("/repos/%s/%s/traffic", url.PathEscape(owner), url.PathEscape(name))
+	daily := url.Values{"per": {"day"}}
+
+	var views struct {
+		Count   int                `json:"count"`
+		Uniques int                `json:"uniques"`
+		Views   []restTrafficCount `json:"views"`
+	}
+	if err := a.restGet(ctx, base+"/views", daily, &views); err != nil {
+		return domain.Traffic{}, err
+	}
+	var clones struct {
+		Count   int                `json:"count"`
+		Uniques int                `json:"uniques"`
+		Clones  []restTrafficCount `json:"clones"`
+	}
+	if err := a.restGet(ctx, base+"/clones", daily, &clones); err != nil {
+		return domain.Traffic{}, err
+	}
+	var referrers []struct {
+		Referrer string `json:"referrer"`
+		Count    int    `json:"count"`
+		Uniques  int    `json:"uniques"`
+	}
+	if err := a.restGet(ctx, base+"/popular/referrers", nil, &referrers); err != nil {
+		return domain.Traffic{}, err
+	}
+	var paths []struct {
+		Path    string `json:"path"`
+		Title   string `json:"title"`
+		Count   int    `json:"count"`
+		Uniques int    `json:"uniques"`
+	}
+	if err := a.restGet(ctx, base+"/popular/paths", nil, &paths); err != nil {
+		return domain.Traffic{}, err
+	}
+
+	t := domain.Traffic{
+		Repo:         owner + "/" + name,
+		Views:        views.Count,
+		UniqueViews:  views.Uniques,
+		Clones:       clones.Count,
+		UniqueClones: clones.Uniques,
+	}
+	for _, r := range referrers {
+		t.Referrers = append(t.Referrers, domain.TrafficReferrer{Referrer: r.Referrer, Count: r.Count, Uniques: r.Uniques})
+	}
+	for _, p := range paths {
+		t.Paths = append(t.Paths, domain.TrafficPath{Path: p.Path, Title: p.Title, Count: p.Count, Uniques: p.Uniques})
+	}
+
+	days := map[time.Time]*domain.TrafficDay{}
+	day := func(ts time.Time) *domain.TrafficDay {
+		d := ts.UTC().Truncate(24 * time.Hour)
+		if days[d] == nil {
+			days[d] = &domain.TrafficDay{Date: d}
+		}
+		return days[d]
+	}
+	for _, v := range views.Views {
+		d := day(v.Timestamp)
+		d.Views, d.UniqueViews = v.Count, v.Uniques
+	}
+	for _, c := range clones.Clones {
+		d := day(c.Timestamp)
+		d.Clones, d.UniqueClones = c.Count, c.Uniques
+	}
+	for _, d := range days {
+		t.Daily = append(t.Daily, *d)
+	}
+	sort.Slice(t.Daily, func(i, j int) bool { return t.Daily[i].Date.Before(t.Daily[j].Date) })
+	return t, nil
+}
@@ -0,0 +1,52 @@
+package githubadapter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+func TestAdapter_Traffic(t *testing.T) {
+	responses := map[string]string{
+		"/repos/owner/repo/traffic/views": `{"count": 7, "uniques": 3, "views": [
+			{"timestamp": "2025-01-10T00:00:00Z", "count": 4, "uniques": 2},
+			{"timestamp": "2025-01-11T00:00:00Z", "count": 3, "uniques": 1}]}`,
+		"/repos/owner/repo/traffic/clones": `{"count": 2, "uniques": 1, "clones": [
+			{"timestamp": "2025-01-11T00:00:00Z", "count": 2, "uniques": 1}]}`,
+		"/repos/owner/repo/traffic/popular/referrers": `[{"referrer": "google.com", "count": 5, "uniques": 2}]`,
+		"/repos/owner/repo/traffic/popular/paths":     `[{"path": "/owner/repo", "title": "repo", "count": 6, "uniques": 3}]`,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, body)
+	}))
+	defer srv.Close()
+
+	result, err := New(nil, WithREST(srv.Client(), srv.URL)).Traffic(context.Background(), "owner", "repo")
+	require.NoError(t, err)
+
+	assert.Equal(t, domain.Traffic{
+		Repo:         "owner/repo",
+		Views:        7,
+		UniqueViews:  3,
+		Clones:       2,
+		UniqueClones: 1,
+		Referrers:    []domain.TrafficReferrer{{Referrer: "google.com", Count: 5, Uniques: 2}},
+		Paths:        []domain.TrafficPath{{Path: "/owner/repo", Title: "repo", Count: 6, Uniques: 3}},
+		Daily: []domain.TrafficDay{
+			{Date: time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC), Views: 4, UniqueViews: 2},
+			{Date: time.Date(2025, 1, 11, 0, 0, 0, 0, time.UTC), Views: 3, UniqueViews: 1, Clones: 2, UniqueClones: 1},
+		},
+	}, result)
+}
@@ -0,0 +1,440 @@
+package gitlabadapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+// DefaultURL is the URL of gitlab.com.
+const DefaultURL = "https://gitlab.com"
+
+// maxPageSize is the largest page GitLab serves for list endpoints.
+const maxPageSize = 100
+
+// releaseScanProjects caps how many recently active projects RecentReleases inspects.
+const releaseScanProjects = 20
+
+// Adapter implements ports.GitlabPort using the GitLab REST API v4. It works
+// with gitlab.com as well as self-managed instances.
+type Adapter struct {
+	client  *http.Client
+	baseURL string
+
+	mu       sync.Mutex
+	projects map[int]domain.Repo
+}
+
+// New returns an adapter for the GitLab instance at baseURL (DefaultURL if
+// empty). The client is expected to authenticate its requests, e.g. via
+// oauth2.NewClient; a nil client means http.DefaultClient.
+func New(client *http.Client, baseURL string) *Adapter {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if baseURL == "" {
+		baseURL = DefaultURL
+	}
+	return &Adapter{
+		client:   client,
+		baseURL:  strings.TrimRight(baseURL, "/"),
+		projects: map[int]domain.Repo{},
+	}
+}
+
+// REST lightweight types local to the adapter
+type glProject struct {
+	ID                int             `json:"id"`
+	PathWithNamespace string          `json:"path_with_namespace"`
+	WebURL            string          `json:"web_url"`
+	Description       string          `json:"description"`
+	Visibility        string          `json:"visibility"`
+	StarCount         int             `json:"star_count"`
+	Archived          bool            `json:"archived"`
+	Mirror            bool            `json:"mirror"`
+	ImportURL         string          `json:"import_url"`
+	Topics            []string        `json:"topics"`
+	CreatedAt         time.Time       `json:"created_at"`
+	LastActivityAt    time.Time       `json:"last_activity_at"`
+	ForkedFromProject json.RawMessage `json:"forked_from_project"`
+}
+
+type glRelease struct {
+	Name       string    `json:"name"`
+	TagName    string    `json:"tag_name"`
+	ReleasedAt time.Time `json:"released_at"`
+	Upcoming   bool      `json:"upcoming_release"`
+	Links      struct {
+		Self string `json:"self"`
+	} `json:"_links"`
+}
+
+type glMergeRequest struct {
+	IID       int        `json:"iid"`
+	ProjectID int        `json:"project_id"`
+	Title     string     `json:"title"`
+	WebURL    string     `json:"web_url"`
+	State     string     `json:"state"`
+	Labels    []string   `json:"labels"`
+	CreatedAt time.Time  `json:"created_at"`
+	MergedAt  *time.Time `json:"merged_at"`
+}
+
+type glIssue struct {
+	IID       int       `json:"iid"`
+	ProjectID int       `json:"project_id"`
+	Title     string    `json:"title"`
+	WebURL    string    `json:"web_url"`
+	State     string    `json:"state"`
+	Labels    []string  `json:"labels"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type glEvent struct {
+	ProjectID int       `json:"project_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type glGroup struct {
+	FullPath  string `json:"full_path"`
+	Name      string `json:"name"`
+	AvatarURL string `json:"avatar_url"`
+	WebURL    string `json:"web_url"`
+}
+
+// get fetches path relative to the API root and decodes the JSON response into out.
+func (a *Adapter) get(ctx context.Context, path string, query url.Values, out interface{}) error {
+	endpoint := a.baseURL + "/api/v4" + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("GET %s: %s", path, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// paginate requests pages of a list endpoint until count items have been
+// collected or the list is exhausted. Items for which keep returns false are
+// skipped; an error from keep aborts paging.
+func paginate[T any](ctx context.Context, a *Adapter, path string, query url.Values, count int, keep func(T) (bool, error)) ([]T, error) {
+	var out []T
+	perPage := min(count, maxPageSize)
+	if keep != nil {
+		// rejected items leave gaps, so don't shrink the pages
+		perPage = maxPageSize
+	}
+	for page := 1; len(out) < count; page++ {
+		q := url.Values{}
+		for k, v := range query {
+			q[k] = v
+		}
+		q.Set("per_page", strconv.Itoa(perPage))
+		q.Set("page", strconv.Itoa(page))
+
+		var items []T
+		if err := a.get(ctx, path, q, &items); err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			if keep != nil {
+				ok, err := keep(item)
+				if err != nil {
+					return nil, err
+				}
+				if !ok {
+					continue
+				}
+			}
+			out = append(out, item)
+			if len(out) == count {
+				return out, nil
+			}
+		}
+		if len(items) < perPage {
+			break
+		}
+	}
+	return out, nil
+}
+
+// ViewerLogin returns the username of the authenticated user.
+func (a *Adapter) ViewerLogin(ctx context.Context) (string, error) {
+	var user struct {
+		Username string `json:"username"`
+	}
+	if err := a.get(ctx, "/user", nil, &user); err != nil {
+		return "", err
+	}
+	return user.Username, nil
+}
+
+// RecentProjects returns the user's most recently created projects, either
+// forks or non-forks. Projects rejected by keep are skipped.
+func (a *Adapter) RecentProjects(ctx context.Context, username string, count int, isFork bool, keep func(domain.Repo) bool) ([]domain.Repo, error) {
+	query := url.Values{"order_by": {"created_at"}, "sort": {"desc"}}
+	projects, err := paginate(ctx, a, "/users/"+url.PathEscape(username)+"/projects", query, count, func(p glProject) (bool, error) {
+		return isForkProject(p) == isFork && keepRepo(keep, repoFromGL(p)), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]domain.Repo, 0, len(projects))
+	for _, p := range projects {
+		out = append(out, repoFromGL(p))
+	}
+	return out, nil
+}
+
+// RecentMergeRequests returns merge requests authored by the user, newest
+// first. Merge requests to projects rejected by keep are skipped.
+func (a *Adapter) RecentMergeRequests(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.PullRequest, error) {
+	query := url.Values{"author_username": {username}, "scope": {"all"}, "order_by": {"created_at"}, "sort": {"desc"}}
+	mrs, err := paginate(ctx, a, "/merge_requests", query, count, func(mr glMergeRequest) (bool, error) {
+		return a.keepProject(ctx, mr.ProjectID, keep)
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]domain.PullRequest, 0, len(mrs))
+	for _, mr := range mrs {
+		repo, err := a.project(ctx, mr.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		pr := domain.PullRequest{
+			Title:     mr.Title,
+			URL:       mr.WebURL,
+			State:     stateFromGL(mr.State),
+			CreatedAt: mr.CreatedAt,
+			Repo:      repo,
+			Number:    mr.IID,
+			Labels:    mr.Labels,
+		}
+		if mr.MergedAt != nil {
+			pr.MergedAt = *mr.MergedAt
+		}
+		out = append(out, pr)
+	}
+	return out, nil
+}
+
+// RecentIssues returns issues opened by the user, newest first. Issues in
+// projects rejected by keep are skipped.
+func (a *Adapter) RecentIssues(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Issue, error) {
+	query := url.Values{"author_username": {username}, "scope": {"all"}, "order_by": {"created_at"}, "sort": {"desc"}}
+	issues, err := paginate(ctx, a, "/issues", query, count, func(is glIssue) (bool, error) {
+		return a.keepProject(ctx, is.ProjectID, keep)
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]domain.Issue, 0, len(issues))
+	for _, is := range issues {
+		repo, err := a.project(ctx, is.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, domain.Issue{
+			Repo:       repo,
+			OccurredAt: is.CreatedAt,
+			Title:      is.Title,
+			Number:     is.IID,
+			URL:        is.WebURL,
+			State:      stateFromGL(is.State),
+			Labels:     is.Labels,
+		})
+	}
+	return out, nil
+}
+
+// RecentReleases returns the user's recently active projects that have a
+// published release, with LastRelease set. Upcoming releases and projects
+// rejected by keep are skipped.
+func (a *Adapter) RecentReleases(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Repo, error) {
+	query := url.Values{"order_by": {"last_activity_at"}, "sort": {"desc"}}
+	projects, err := paginate(ctx, a, "/users/"+url.PathEscape(username)+"/projects", query, releaseScanProjects, func(p glProject) (bool, error) {
+		return keepRepo(keep, repoFromGL(p)), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var out []domain.Repo
+	for _, p := range projects {
+		var releases []glRelease
+		if err := a.get(ctx, fmt.Sprintf("/projects/%d/releases", p.ID), url.Values{"per_page": {"1"}}, &releases); err != nil {
+			return nil, err
+		}
+		if len(releases) == 0 || releases[0].Upcoming {
+			continue
+		}
+		r := releases[0]
+		repo := repoFromGL(p)
+		repo.LastRelease = domain.Release{
+			Name:        r.Name,
+			TagName:     r.TagName,
+			PublishedAt: r.ReleasedAt,
+			URL:         r.Links.Self,
+		}
+		out = append(out, repo)
+		if len(out) == count {
+			break
+		}
+	}
+	return out, nil
+}
+
+// RecentStars returns projects starred by the user, skipping those rejected
+// by keep. GitLab does not expose when a project was starred, so StarredAt is
+// left zero.
+func (a *Adapter) RecentStars(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Star, error) {
+	projects, err := paginate(ctx, a, "/users/"+url.PathEscape(username)+"/starred_projects", nil, count, func(p glProject) (bool, error) {
+		return keepRepo(keep, repoFromGL(p)), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]domain.Star, 0, len(projects))
+	for _, p := range projects {
+		out = append(out, domain.Star{Repo: repoFromGL(p)})
+	}
+	return out, nil
+}
+
+// RecentContributions returns the projects the user recently pushed to, one
+// entry per project with the time of the latest push, newest first. Projects
+// rejected by keep are skipped.
+func (a *Adapter) RecentContributions(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Contribution, error) {
+	seen := map[int]bool{}
+	events, err := paginate(ctx, a, "/users/"+url.PathEscape(username)+"/events", url.Values{"action": {"pushed"}}, count, func(e glEvent) (bool, error) {
+		if e.ProjectID == 0 || seen[e.ProjectID] {
+			return false, nil
+		}
+		seen[e.ProjectID] = true
+		return a.keepProject(ctx, e.ProjectID, keep)
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]domain.Contribution, 0, len(events))
+	for _, e := range events {
+		repo, err := a.project(ctx, e.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, domain.Contribution{OccurredAt: e.CreatedAt, Repo: repo})
+	}
+	return out, nil
+}
+
+// Groups returns the groups the authenticated user is a member of.
+func (a *Adapter) Groups(ctx context.Context, count int) ([]domain.User, error) {
+	groups, err := paginate[glGroup](ctx, a, "/groups", url.Values{"min_access_level": {"10"}}, count, nil)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]domain.User, 0, len(groups))
+	for _, g := range groups {
+		out = append(out, domain.User{Login: g.FullPath, Name: g.Name, AvatarURL: g.AvatarURL, URL: g.WebURL})
+	}
+	return out, nil
+}
+
+// keepProject reports whether keep accepts the project with the given ID.
+// A nil keep accepts every project without looking it up.
+func (a *Adapter) keepProject(ctx context.Context, id int, keep func(domain.Repo) bool) (bool, error) {
+	if keep == nil {
+		return true, nil
+	}
+	repo, err := a.project(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	return keep(repo), nil
+}
+
+// keepRepo reports whether keep accepts repo; a nil keep accepts everything.
+func keepRepo(keep func(domain.Repo) bool, repo domain.Repo) bool {
+	return keep == nil || keep(repo)
+}
+
+// project returns a project by ID, caching results for the adapter's lifetime.
+func (a *Adapter) project(ctx context.Context, id int) (domain.Repo, error) {
+	a.mu.Lock()
+	repo, ok := a.projects[id]
+	a.mu.Unlock()
+	if ok {
+		return repo, nil
+	}
+	var p glProject
+	if err := a.get(ctx, fmt.Sprintf("/projects/%d", id), nil, &p); err != nil {
+		return domain.Repo{}, err
+	}
+	repo = repoFromGL(p)
+	a.mu.Lock()
+	a.projects[id] = repo
+	a.mu.Unlock()
+	return repo, nil
+}
+
+func repoFromGL(p glProject) domain.Repo {
+	repo := domain.Repo{
+		Name:        p.PathWithNamespace,
+		URL:         p.WebURL,
+		Description: p.Description,
+		IsPrivate:   p.Visibility != "" && p.Visibility != "public",
+		IsArchived:  p.Archived,
+		IsFork:      isForkProject(p),
+		Topics:      p.Topics,
+		Stargazers:  p.StarCount,
+		CreatedAt:   p.CreatedAt,
+		PushedAt:    p.LastActivityAt,
+	}
+	if p.Mirror {
+		repo.MirrorURL = stripCredentials(p.ImportURL)
+	}
+	return repo
+}
+
+// stripCredentials removes the user info GitLab may keep in a pull mirror's
+// import URL.
+func stripCredentials(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	u.User = nil
+	return u.String()
+}
+
+func isForkProject(p glProject) bool {
+	return len(p.ForkedFromProject) > 0 && string(p.ForkedFromProject) != "null"
+}
+
+// stateFromGL maps GitLab states onto the upper-case states used by GitHub.
+func stateFromGL(state string) string {
+	switch state {
+	case "opened":
+		return "OPEN"
+	default:
+		return strings.ToUpper(state)
+	}
+}
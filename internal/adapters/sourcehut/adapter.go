@@ -0,0 +1,233 @@
+package sourcehutadapter
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shurcooL/graphql"
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+// Default service URLs of the public sourcehut instance.
+const (
+	DefaultGitURL  = "https://git.sr.ht"
+	DefaultTodoURL = "https://todo.sr.ht"
+)
+
+// maxPages bounds how many result pages are scanned per query; sourcehut
+// returns its lists in a fixed order, so results are sorted client-side.
+const maxPages = 5
+
+// Cursor is the pagination cursor scalar of the sourcehut GraphQL APIs.
+type Cursor string
+
+// Adapter implements ports.SourcehutPort using the GraphQL APIs of git.sr.ht
+// and todo.sr.ht.
+type Adapter struct {
+	git     *graphql.Client
+	todo    *graphql.Client
+	gitURL  string
+	todoURL string
+}
+
+// New returns an adapter for the given git and todo service URLs (the public
+// instance if empty). The client is expected to authenticate its requests;
+// a nil client means http.DefaultClient.
+func New(client *http.Client, gitURL, todoURL string) *Adapter {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if gitURL == "" {
+		gitURL = DefaultGitURL
+	}
+	if todoURL == "" {
+		todoURL = DefaultTodoURL
+	}
+	gitURL = strings.TrimRight(gitURL, "/")
+	todoURL = strings.TrimRight(todoURL, "/")
+	return &Adapter{
+		git:     graphql.NewClient(gitURL+"/query", client),
+		todo:    graphql.NewClient(todoURL+"/query", client),
+		gitURL:  gitURL,
+		todoURL: todoURL,
+	}
+}
+
+// GraphQL lightweight types local to the adapter
+type srhtRepository struct {
+	Name        graphql.String
+	Description graphql.String
+	Visibility  graphql.String
+	Created     time.Time
+	Updated     time.Time
+	Owner       struct {
+		CanonicalName graphql.String
+	}
+}
+
+type srhtTicket struct {
+	ID      graphql.Int
+	Subject graphql.String
+	Status  graphql.String
+	Created time.Time
+	Labels  []struct {
+		Name graphql.String
+	}
+}
+
+type srhtTracker struct {
+	Name       graphql.String
+	Visibility graphql.String
+	Owner      struct {
+		CanonicalName graphql.String
+	}
+	Tickets struct {
+		Results []srhtTicket
+	} `graphql:"tickets"`
+}
+
+// ViewerLogin returns the username of the token's owner.
+func (a *Adapter) ViewerLogin(ctx context.Context) (string, error) {
+	var q struct {
+		Me struct {
+			Username graphql.String
+		}
+	}
+	if err := a.git.Query(ctx, &q, nil); err != nil {
+		return "", err
+	}
+	return string(q.Me.Username), nil
+}
+
+// RecentRepos returns the user's most recently created repositories,
+// skipping those rejected by keep.
+func (a *Adapter) RecentRepos(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Repo, error) {
+	var q struct {
+		User struct {
+			Repositories struct {
+				Results []srhtRepository
+				Cursor  *Cursor
+			} `graphql:"repositories(cursor: $cursor)"`
+		} `graphql:"user(username: $username)"`
+	}
+
+	var out []domain.Repo
+	var cursor *Cursor
+	for page := 0; page < maxPages; page++ {
+		vars := map[string]interface{}{
+			"username": graphql.String(strings.TrimPrefix(username, "~")),
+			"cursor":   cursor,
+		}
+		if err := a.git.Query(ctx, &q, vars); err != nil {
+			return nil, err
+		}
+		for _, r := range q.User.Repositories.Results {
+			repo := a.repoFromSrht(r)
+			if keep != nil && !keep(repo) {
+				continue
+			}
+			out = append(out, repo)
+		}
+		cursor = q.User.Repositories.Cursor
+		if cursor == nil {
+			break
+		}
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].CreatedAt.After(out[j].CreatedAt)
+	})
+	if len(out) > count {
+		out = out[:count]
+	}
+	return out, nil
+}
+
+// RecentTickets returns the most recently submitted tickets on the user's
+// trackers. The tracker is the ticket's Repo; tickets on trackers rejected by
+// keep are skipped.
+func (a *Adapter) RecentTickets(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Issue, error) {
+	var q struct {
+		User struct {
+			Trackers struct {
+				Results []srhtTracker
+				Cursor  *Cursor
+			} `graphql:"trackers(cursor: $cursor)"`
+		} `graphql:"user(username: $username)"`
+	}
+
+	var out []domain.Issue
+	var cursor *Cursor
+	for page := 0; page < maxPages; page++ {
+		vars := map[string]interface{}{
+			"username": graphql.String(strings.TrimPrefix(username, "~")),
+			"cursor":   cursor,
+		}
+		if err := a.todo.Query(ctx, &q, vars); err != nil {
+			return nil, err
+		}
+		for _, tr := range q.User.Trackers.Results {
+			name := string(tr.Owner.CanonicalName) + "/" + string(tr.Name)
+			repo := domain.Repo{
+				Name:      name,
+				URL:       a.todoURL + "/" + name,
+				IsPrivate: string(tr.Visibility) != "PUBLIC",
+			}
+			if keep != nil && !keep(repo) {
+				continue
+			}
+			for _, t := range tr.Tickets.Results {
+				issue := domain.Issue{
+					Repo:       repo,
+					OccurredAt: t.Created,
+					Title:      string(t.Subject),
+					Number:     int(t.ID),
+					URL:        repo.URL + "/" + strconv.Itoa(int(t.ID)),
+					State:      stateFromSrht(string(t.Status)),
+				}
+				for _, l := range t.Labels {
+					issue.Labels = append(issue.Labels, string(l.Name))
+				}
+				out = append(out, issue)
+			}
+		}
+		cursor = q.User.Trackers.Cursor
+		if cursor == nil {
+			break
+		}
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].OccurredAt.After(out[j].OccurredAt)
+	})
+	if len(out) > count {
+		out = out[:count]
+	}
+	return out, nil
+}
+
+func (a *Adapter) repoFromSrht(r srhtRepository) domain.Repo {
+	name := string(r.Owner.CanonicalName) + "/" + string(r.Name)
+	return domain.Repo{
+		Name:        name,
+		URL:         a.gitURL + "/" + name,
+		Description: string(r.Description),
+		// Unlisted repositories are reachable by URL but not advertised, so
+		// they are treated like private ones; the same goes for trackers.
+		IsPrivate: string(r.Visibility) != "PUBLIC",
+		CreatedAt: r.Created,
+		PushedAt:  r.Updated,
+	}
+}
+
+// stateFromSrht maps ticket statuses onto the issue states used by GitHub.
+func stateFromSrht(status string) string {
+	if status == "RESOLVED" {
+		return "CLOSED"
+	}
+	return "OPEN"
+}
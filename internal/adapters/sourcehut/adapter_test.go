@@ -0,0 +1,122 @@
+package sourcehutadapter
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+// fakeSourcehut serves the git.sr.ht and todo.sr.ht GraphQL endpoints under
+// /git/query and /todo/query for the user "~me".
+func fakeSourcehut(t *testing.T) *httptest.Server {
+	t.Helper()
+	reply := func(w http.ResponseWriter, data string) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":` + data + `}`))
+	}
+	decode := func(r *http.Request) (string, map[string]interface{}) {
+		var body struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		return body.Query, body.Variables
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/git/query", func(w http.ResponseWriter, r *http.Request) {
+		query, vars := decode(r)
+		switch {
+		case strings.Contains(query, "me{"):
+			reply(w, `{"me":{"username":"me"}}`)
+		case vars["cursor"] == nil:
+			assert.Equal(t, "me", vars["username"])
+			reply(w, `{"user":{"repositories":{"cursor":"next","results":[
+				{"name":"old","visibility":"PUBLIC","created":"2024-01-01T00:00:00Z","owner":{"canonicalName":"~me"}},
+				{"name":"hidden","visibility":"UNLISTED","created":"2024-06-01T00:00:00Z","owner":{"canonicalName":"~me"}}
+			]}}}`)
+		default:
+			assert.Equal(t, "next", vars["cursor"])
+			reply(w, `{"user":{"repositories":{"cursor":null,"results":[
+				{"name":"new","description":"Newest","visibility":"PUBLIC","created":"2025-01-01T00:00:00Z","updated":"2025-02-01T00:00:00Z","owner":{"canonicalName":"~me"}}
+			]}}}`)
+		}
+	})
+	mux.HandleFunc("/todo/query", func(w http.ResponseWriter, r *http.Request) {
+		decode(r)
+		reply(w, `{"user":{"trackers":{"cursor":null,"results":[
+			{"name":"app","visibility":"PUBLIC","owner":{"canonicalName":"~me"},"tickets":{"results":[
+				{"id":1,"subject":"Crash","status":"RESOLVED","created":"2024-01-01T00:00:00Z","labels":[]},
+				{"id":2,"subject":"Docs","status":"REPORTED","created":"2025-01-01T00:00:00Z","labels":[{"name":"docs"}]}
+			]}},
+			{"name":"ops","visibility":"PRIVATE","owner":{"canonicalName":"~me"},"tickets":{"results":[
+				{"id":1,"subject":"Rotate keys","status":"REPORTED","created":"2024-06-01T00:00:00Z","labels":[]}
+			]}}
+		]}}}`)
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestAdapter_ViewerLogin(t *testing.T) {
+	srv := fakeSourcehut(t)
+	login, err := New(srv.Client(), srv.URL+"/git", srv.URL+"/todo").ViewerLogin(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "me", login)
+}
+
+func TestAdapter_RecentRepos(t *testing.T) {
+	srv := fakeSourcehut(t)
+	a := New(srv.Client(), srv.URL+"/git", srv.URL+"/todo")
+
+	repos, err := a.RecentRepos(context.Background(), "~me", 2, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Repo{
+		{Name: "~me/new", URL: srv.URL + "/git/~me/new", Description: "Newest",
+			CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), PushedAt: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{Name: "~me/hidden", URL: srv.URL + "/git/~me/hidden", IsPrivate: true, CreatedAt: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)},
+	}, repos)
+}
+
+func TestAdapter_RecentReposKeep(t *testing.T) {
+	srv := fakeSourcehut(t)
+	a := New(srv.Client(), srv.URL+"/git", srv.URL+"/todo")
+	keep := func(r domain.Repo) bool { return !r.IsPrivate }
+
+	repos, err := a.RecentRepos(context.Background(), "~me", 2, keep)
+	require.NoError(t, err)
+	var names []string
+	for _, r := range repos {
+		names = append(names, r.Name)
+		assert.False(t, r.IsPrivate)
+	}
+	assert.NotContains(t, names, "~me/hidden")
+	assert.Contains(t, names, "~me/new")
+}
+
+func TestAdapter_RecentTickets(t *testing.T) {
+	srv := fakeSourcehut(t)
+	a := New(srv.Client(), srv.URL+"/git", srv.URL+"/todo")
+
+	tickets, err := a.RecentTickets(context.Background(), "me", 5, nil)
+	require.NoError(t, err)
+	tracker := domain.Repo{Name: "~me/app", URL: srv.URL + "/todo/~me/app"}
+	private := domain.Repo{Name: "~me/ops", URL: srv.URL + "/todo/~me/ops", IsPrivate: true}
+	assert.Equal(t, []domain.Issue{
+		{Repo: tracker, Title: "Docs", Number: 2, URL: tracker.URL + "/2", State: "OPEN", Labels: []string{"docs"},
+			OccurredAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Repo: private, Title: "Rotate keys", Number: 1, URL: private.URL + "/1", State: "OPEN",
+			OccurredAt: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)},
+		{Repo: tracker, Title: "Crash", Number: 1, URL: tracker.URL + "/1", State: "CLOSED",
+			OccurredAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}, tickets)
+}
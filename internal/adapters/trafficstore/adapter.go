@@ -0,0 +1,76 @@
+package trafficstoreadapter
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+// Adapter implements ports.TrafficHistoryPort with a single JSON file keyed by
+// repository. A missing file is treated as empty history.
+type Adapter struct {
+	path string
+	mu   sync.Mutex
+}
+
+func New(path string) *Adapter {
+	return &Adapter{path: path}
+}
+
+func (a *Adapter) Load(repo string) ([]domain.TrafficDay, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	all, err := a.read()
+	if err != nil {
+		return nil, err
+	}
+	return all[repo], nil
+}
+
+func (a *Adapter) Save(repo string, days []domain.TrafficDay) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	all, err := a.read()
+	if err != nil {
+		return err
+	}
+	all[repo] = days
+
+	data, err := json.MarshalIndent(all, "", "  ")
+	if err != nil {
+		return err
+	}
+	// Write to a temporary file first so an interrupted run keeps the old history.
+	tmp, err := os.CreateTemp(filepath.Dir(a.path), filepath.Base(a.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), a.path)
+}
+
+func (a *Adapter) read() (map[string][]domain.TrafficDay, error) {
+	all := map[string][]domain.TrafficDay{}
+	data, err := os.ReadFile(a.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return all, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+	return all, nil
+}
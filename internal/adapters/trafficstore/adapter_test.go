@@ -0,0 +1,27 @@
+package trafficstoreadapter
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+func TestAdapter_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traffic.json")
+	days := []domain.TrafficDay{{Date: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Views: 4, UniqueViews: 2}}
+
+	empty, err := New(path).Load("owner/repo")
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+
+	require.NoError(t, New(path).Save("owner/repo", days))
+	require.NoError(t, New(path).Save("owner/other", nil))
+
+	loaded, err := New(path).Load("owner/repo")
+	require.NoError(t, err)
+	assert.Equal(t, days, loaded)
+}
@@ -8,10 +8,44 @@ type Contribution struct {
 	Repo       Repo
 }
 
+// Commit represents a commit authored by the user.
+type Commit struct {
+	// Message is the first line of the commit message.
+	Message     string
+	SHA         string
+	URL         string
+	CommittedAt time.Time
+	Repo        Repo
+}
+
+// Issue represents an issue opened by the user.
 type Issue struct {
 	Repo       Repo
 	OccurredAt time.Time
 	Title      string
+	Number     int
+	URL        string
+	State      string
+	Labels     []string
+}
+
+// Discussion represents a GitHub Discussion or a comment on one.
+// For comments, URL and CreatedAt refer to the comment itself.
+type Discussion struct {
+	Title      string
+	URL        string
+	Category   string
+	IsAnswered bool
+	CreatedAt  time.Time
+	Repo       Repo
+}
+
+// ExternalContribution summarizes merged pull requests to a repository
+// the user does not own.
+type ExternalContribution struct {
+	Repo         Repo
+	PullRequests int
+	LastMergedAt time.Time
 }
 
 // Gist represents a gist.
@@ -22,6 +56,13 @@ type Gist struct {
 	CreatedAt   time.Time
 }
 
+// ContributionDay holds the number of contributions on a single day, e.g.
+// for rendering a contribution heatmap.
+type ContributionDay struct {
+	Date  time.Time
+	Count int
+}
+
 // Star represents a star/favorite event.
 type Star struct {
 	StarredAt time.Time
@@ -35,14 +76,42 @@ type PullRequest struct {
 	State     string
 	CreatedAt time.Time
 	Repo      Repo
+	Number    int
+	MergedAt  time.Time
+	Additions int
+	Deletions int
+	Labels    []string
+}
+
+// Review represents a pull request review submitted by the user.
+type Review struct {
+	PullRequest PullRequest
+	// State is one of APPROVED, CHANGES_REQUESTED, COMMENTED, DISMISSED or PENDING.
+	State      string
+	URL        string
+	OccurredAt time.Time
 }
 
 // Release represents a release.
 type Release struct {
-	Name        string
-	TagName     string
-	PublishedAt time.Time
-	URL         string
+	Name         string
+	TagName      string
+	PublishedAt  time.Time
+	URL          string
+	IsPrerelease bool
+	// Description holds the release notes as Markdown.
+	Description string
+	Assets      []ReleaseAsset
+	// Downloads is the sum of all asset download counts.
+	Downloads int
+}
+
+// ReleaseAsset represents a file attached to a release.
+type ReleaseAsset struct {
+	Name          string
+	URL           string
+	Size          int
+	DownloadCount int
 }
 
 // Repo represents a git repo.
@@ -51,14 +120,74 @@ type Repo struct {
 	URL         string
 	Description string
 	IsPrivate   bool
+	IsArchived  bool
+	IsFork      bool
+	Topics      []string
 	Stargazers  int
 	LastRelease Release
+	CreatedAt   time.Time
+	PushedAt    time.Time
+	// MirrorURL is the upstream URL of a mirrored repository, if the forge
+	// reports it.
+	MirrorURL string
+	// Forge names the forge hosting the repository, e.g. "github" or
+	// "gitlab". Only set by functions that merge results across forges.
+	Forge string
+	// StargazersDelta is the number of stars gained within a time window.
+	// Only set by functions that rank repositories by recent stars.
+	StargazersDelta int
+}
+
+// Contributor represents a user who committed to a repository.
+type Contributor struct {
+	User    User
+	Commits int
+}
+
+// Milestone represents a repository milestone.
+type Milestone struct {
+	Title              string
+	Description        string
+	URL                string
+	State              string
+	DueOn              time.Time
+	ProgressPercentage float64
+	OpenIssues         int
+	ClosedIssues       int
+}
+
+// Language represents a programming language used in a repository.
+type Language struct {
+	Name  string
+	Color string
+	// Size is the number of bytes written in the language.
+	Size    int
+	Percent float64
 }
 
 // Sponsor represents a sponsor.
 type Sponsor struct {
-	User      User
-	CreatedAt time.Time
+	User             User
+	CreatedAt        time.Time
+	Tier             SponsorTier
+	IsOneTimePayment bool
+}
+
+// SponsorTier represents the tier a sponsorship was made at.
+// Fields are empty when the tier is not visible to the viewer.
+type SponsorTier struct {
+	Name                  string
+	MonthlyPriceInDollars int
+}
+
+// SponsorsGoal represents the active goal of a sponsors listing.
+type SponsorsGoal struct {
+	// Kind is TOTAL_SPONSORS_COUNT or MONTHLY_SPONSORSHIP_AMOUNT.
+	Kind            string
+	Title           string
+	Description     string
+	PercentComplete int
+	TargetValue     int
 }
 
 // User represents a SCM user.
@@ -69,6 +198,93 @@ type User struct {
 	URL       string
 }
 
+// Profile represents the public profile of a SCM user.
+type Profile struct {
+	Login         string
+	Name          string
+	Bio           string
+	Company       string
+	Location      string
+	AvatarURL     string
+	URL           string
+	WebsiteURL    string
+	StatusEmoji   string
+	StatusMessage string
+	CreatedAt     time.Time
+	Followers     int
+	Following     int
+	PublicRepos   int
+}
+
+// Activity represents an entry of the user's public event timeline, e.g.
+// "pushed" "3 commits to main" in Repo.
+type Activity struct {
+	Type      string
+	Verb      string
+	Object    string
+	Repo      Repo
+	URL       string
+	CreatedAt time.Time
+}
+
+// Traffic holds the view and clone statistics of a repository. Views, Clones
+// and their unique counterparts cover the last 14 days as reported by GitHub;
+// TotalViews and TotalClones sum up Daily, which may reach further back when
+// a history store is configured.
+type Traffic struct {
+	Repo         string
+	Views        int
+	UniqueViews  int
+	Clones       int
+	UniqueClones int
+	Referrers    []TrafficReferrer
+	Paths        []TrafficPath
+	Daily        []TrafficDay
+	TotalViews   int
+	TotalClones  int
+	Since        time.Time
+}
+
+// TrafficDay holds the traffic of a repository on a single day.
+type TrafficDay struct {
+	Date         time.Time
+	Views        int
+	UniqueViews  int
+	Clones       int
+	UniqueClones int
+}
+
+// TrafficReferrer is a site referring visitors to a repository.
+type TrafficReferrer struct {
+	Referrer string
+	Count    int
+	Uniques  int
+}
+
+// TrafficPath is a popular page of a repository.
+type TrafficPath struct {
+	Path    string
+	Title   string
+	Count   int
+	Uniques int
+}
+
+// WorkflowRun represents a GitHub Actions workflow run. Duration is only set
+// for completed runs.
+type WorkflowRun struct {
+	Name       string
+	Title      string
+	Status     string
+	Conclusion string
+	Branch     string
+	Event      string
+	URL        string
+	RunNumber  int
+	StartedAt  time.Time
+	UpdatedAt  time.Time
+	Duration   time.Duration
+}
+
 // RSSEntry represents a single RSS entry.
 type RSSEntry struct {
 	Title       string
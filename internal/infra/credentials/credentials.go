@@ -0,0 +1,176 @@
+// Package credentials looks up API tokens from the places developers and
+// deployments usually keep them: environment variables, secret files, the gh
+// CLI configuration and git credential helpers.
+package credentials
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// Source yields a token for a host. An empty token without error means the
+// source has nothing to offer and the next source should be tried.
+type Source interface {
+	// Name describes the source for logging. It never contains the token.
+	Name() string
+	Token(ctx context.Context, host string) (string, error)
+}
+
+// Resolve returns the token of the first source that has one, together with
+// that source's name. It returns an empty token if no source has one.
+func Resolve(ctx context.Context, host string, sources ...Source) (token, name string, err error) {
+	for _, src := range sources {
+		token, err := src.Token(ctx, host)
+		if err != nil {
+			return "", "", fmt.Errorf("%s: %w", src.Name(), err)
+		}
+		if token != "" {
+			return token, src.Name(), nil
+		}
+	}
+	return "", "", nil
+}
+
+type envSource string
+
+// Env reads the token from the named environment variable.
+func Env(name string) Source { return envSource(name) }
+
+func (e envSource) Name() string { return "environment variable " + string(e) }
+
+func (e envSource) Token(context.Context, string) (string, error) {
+	return strings.TrimSpace(os.Getenv(string(e))), nil
+}
+
+type fileSource string
+
+// File reads the token from a file, e.g. a mounted Docker or Kubernetes
+// secret. An empty path disables the source; a configured but unreadable
+// file is an error.
+func File(path string) Source { return fileSource(path) }
+
+func (f fileSource) Name() string { return "token file " + string(f) }
+
+func (f fileSource) Token(context.Context, string) (string, error) {
+	if f == "" {
+		return "", nil
+	}
+	data, err := os.ReadFile(string(f))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+type ghSource struct{}
+
+// GHConfig reads the token stored by `gh auth login` in the gh CLI's
+// hosts.yml. Tokens kept in the system keyring are not visible here.
+func GHConfig() Source { return ghSource{} }
+
+func (ghSource) Name() string { return "gh CLI config" }
+
+func (ghSource) Token(_ context.Context, host string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(ghConfigDir(), "hosts.yml"))
+	if err != nil {
+		return "", nil
+	}
+	return ghHostToken(data, host), nil
+}
+
+// ghConfigDir mirrors gh's own lookup of its configuration directory.
+func ghConfigDir() string {
+	if dir := os.Getenv("GH_CONFIG_DIR"); dir != "" {
+		return dir
+	}
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "gh")
+	}
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".config", "gh")
+}
+
+// ghHostToken extracts the oauth_token of host from hosts.yml. The file is
+// a flat map of hosts, so a line based scan is sufficient; the host level
+// token is preferred over per-user entries.
+func ghHostToken(data []byte, host string) string {
+	var (
+		inHost    bool
+		hostLevel = -1
+		fallback  string
+	)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		indent := len(line) - len(strings.TrimLeft(line, " \t"))
+		if indent == 0 {
+			inHost = strings.TrimSuffix(trimmed, ":") == host
+			hostLevel = -1
+			continue
+		}
+		if !inHost {
+			continue
+		}
+		if hostLevel < 0 {
+			hostLevel = indent
+		}
+		key, value, ok := strings.Cut(trimmed, ":")
+		if !ok || key != "oauth_token" {
+			continue
+		}
+		value = strings.Trim(strings.TrimSpace(value), `"'`)
+		if indent == hostLevel {
+			return value
+		}
+		if fallback == "" {
+			fallback = value
+		}
+	}
+	return fallback
+}
+
+type gitSource struct{}
+
+// GitCredential asks the configured git credential helpers via
+// `git credential fill`. Interactive prompts are disabled.
+func GitCredential() Source { return gitSource{} }
+
+func (gitSource) Name() string { return "git credential helper" }
+
+func (gitSource) Token(ctx context.Context, host string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", "credential", "fill")
+	cmd.Stdin = strings.NewReader(fmt.Sprintf("protocol=https\nhost=%s\n\n", host))
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0", "GCM_INTERACTIVE=never")
+	out, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.Is(err, exec.ErrNotFound) || errors.As(err, &exitErr) {
+			// git is not installed or has no credential for host.
+			return "", nil
+		}
+		return "", err
+	}
+	return credentialPassword(out), nil
+}
+
+// credentialPassword returns the password field of `git credential` output.
+func credentialPassword(out []byte) string {
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		if value, ok := strings.CutPrefix(scanner.Text(), "password="); ok {
+			return value
+		}
+	}
+	return ""
+}
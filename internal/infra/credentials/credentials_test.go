@@ -0,0 +1,119 @@
+package credentials
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type staticSource struct {
+	name  string
+	token string
+	err   error
+}
+
+func (s staticSource) Name() string { return s.name }
+
+func (s staticSource) Token(context.Context, string) (string, error) { return s.token, s.err }
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name          string
+		sources       []Source
+		expectedToken string
+		expectedName  string
+		expectErr     bool
+	}{
+		{
+			name:          "first source with a token wins",
+			sources:       []Source{staticSource{name: "a"}, staticSource{name: "b", token: "tb"}, staticSource{name: "c", token: "tc"}},
+			expectedToken: "tb",
+			expectedName:  "b",
+		},
+		{
+			name:    "no token",
+			sources: []Source{staticSource{name: "a"}},
+		},
+		{
+			name:      "error stops resolution",
+			sources:   []Source{staticSource{name: "a", err: errors.New("boom")}, staticSource{name: "b", token: "tb"}},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, name, err := Resolve(context.Background(), "github.com", tt.sources...)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedToken, token)
+			assert.Equal(t, tt.expectedName, name)
+		})
+	}
+}
+
+func TestFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("ghp_secret\n"), 0o600))
+
+	token, err := File(path).Token(context.Background(), "github.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "ghp_secret", token)
+
+	token, err = File("").Token(context.Background(), "github.com")
+	assert.NoError(t, err)
+	assert.Empty(t, token)
+
+	_, err = File(filepath.Join(t.TempDir(), "missing")).Token(context.Background(), "github.com")
+	assert.Error(t, err)
+}
+
+func TestGHConfig(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("GH_CONFIG_DIR", dir)
+	hosts := `github.com:
+    users:
+        octocat:
+            oauth_token: gho_user
+    git_protocol: https
+    oauth_token: gho_host
+    user: octocat
+ghe.example.com:
+    users:
+        octocat:
+            oauth_token: gho_enterprise
+    user: octocat
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "hosts.yml"), []byte(hosts), 0o600))
+
+	tests := []struct {
+		host     string
+		expected string
+	}{
+		{host: "github.com", expected: "gho_host"},
+		{host: "ghe.example.com", expected: "gho_enterprise"},
+		{host: "gitlab.com", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			token, err := GHConfig().Token(context.Background(), tt.host)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, token)
+		})
+	}
+}
+
+func TestCredentialPassword(t *testing.T) {
+	out := []byte("protocol=https\nhost=github.com\nusername=octocat\npassword=ghp_secret\n")
+	assert.Equal(t, "ghp_secret", credentialPassword(out))
+	assert.Empty(t, credentialPassword([]byte("protocol=https\n")))
+}
@@ -0,0 +1,137 @@
+// Package githubapp authenticates as a GitHub App installation. It signs a
+// short-lived app JWT and exchanges it for an installation access token.
+package githubapp
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// DefaultAPIURL is the REST API root of github.com.
+const DefaultAPIURL = "https://api.github.com"
+
+// jwtLifetime stays below the ten minutes GitHub accepts for app JWTs.
+const jwtLifetime = 9 * time.Minute
+
+// ParsePrivateKey parses the PEM encoded private key downloaded from the
+// app settings page. Both PKCS#1 and PKCS#8 keys are accepted.
+func ParsePrivateKey(pemData []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(pemData)
+	if block == nil {
+		return nil, errors.New("no PEM data found in GitHub App private key")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse GitHub App private key: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("GitHub App private key is not an RSA key")
+	}
+	return key, nil
+}
+
+// TokenSource yields installation access tokens.
+type TokenSource struct {
+	ctx            context.Context
+	client         *http.Client
+	apiURL         string
+	appID          int64
+	installationID int64
+	key            *rsa.PrivateKey
+	now            func() time.Time
+}
+
+// NewTokenSource returns a token source for the given app installation. The
+// result caches tokens and only requests a new one when the current token is
+// about to expire. A nil client means http.DefaultClient; an empty apiURL
+// means DefaultAPIURL.
+func NewTokenSource(ctx context.Context, client *http.Client, apiURL string, appID, installationID int64, key *rsa.PrivateKey) oauth2.TokenSource {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if apiURL == "" {
+		apiURL = DefaultAPIURL
+	}
+	return oauth2.ReuseTokenSource(nil, &TokenSource{
+		ctx:            ctx,
+		client:         client,
+		apiURL:         strings.TrimRight(apiURL, "/"),
+		appID:          appID,
+		installationID: installationID,
+		key:            key,
+		now:            time.Now,
+	})
+}
+
+// Token exchanges a freshly signed app JWT for an installation token.
+func (s *TokenSource) Token() (*oauth2.Token, error) {
+	jwt, err := s.signJWT()
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("%s/app/installations/%d/access_tokens", s.apiURL, s.installationID)
+	req, err := http.NewRequestWithContext(s.ctx, http.MethodPost, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+jwt)
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("can't request installation token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("can't request installation token: %s", resp.Status)
+	}
+
+	var body struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("can't decode installation token: %w", err)
+	}
+	return &oauth2.Token{AccessToken: body.Token, TokenType: "token", Expiry: body.ExpiresAt}, nil
+}
+
+// signJWT creates the RS256 signed JWT identifying the app itself. The
+// issued-at time is backdated a minute to allow for clock drift.
+func (s *TokenSource) signJWT() (string, error) {
+	now := s.now()
+	header := `{"alg":"RS256","typ":"JWT"}`
+	claims := fmt.Sprintf(`{"iat":%d,"exp":%d,"iss":%s}`,
+		now.Add(-time.Minute).Unix(), now.Add(jwtLifetime).Unix(), strconv.Quote(strconv.FormatInt(s.appID, 10)))
+
+	enc := base64.RawURLEncoding
+	signingInput := enc.EncodeToString([]byte(header)) + "." + enc.EncodeToString([]byte(claims))
+
+	digest := sha256.Sum256([]byte(signingInput))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("can't sign GitHub App JWT: %w", err)
+	}
+	return signingInput + "." + enc.EncodeToString(sig), nil
+}
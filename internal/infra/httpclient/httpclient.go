@@ -0,0 +1,53 @@
+// Package httpclient builds the HTTP clients used to talk to remote APIs,
+// honouring custom CA bundles and proxy settings.
+package httpclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// Options configures the transport of a client built by New.
+type Options struct {
+	// CABundle is the path to a PEM file with additional root certificates,
+	// e.g. for a GitHub Enterprise Server behind an internal CA. The
+	// certificates are trusted in addition to the system roots.
+	CABundle string
+	// Proxy is the URL of an HTTP(S) proxy. When empty, the standard
+	// HTTPS_PROXY, HTTP_PROXY and NO_PROXY variables are used.
+	Proxy string
+}
+
+// New returns an *http.Client configured according to opts.
+func New(opts Options) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if opts.Proxy != "" {
+		proxyURL, err := url.Parse(opts.Proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL %q: %w", opts.Proxy, err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if opts.CABundle != "" {
+		pem, err := os.ReadFile(opts.CABundle)
+		if err != nil {
+			return nil, fmt.Errorf("can't read CA bundle: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA bundle %s", opts.CABundle)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+
+	return &http.Client{Transport: transport}, nil
+}
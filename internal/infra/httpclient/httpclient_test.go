@@ -0,0 +1,69 @@
+package httpclient
+
+import (
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_CABundleTrustsServer(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "ok")
+	}))
+	defer srv.Close()
+
+	bundle := filepath.Join(t.TempDir(), "ca.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	require.NoError(t, os.WriteFile(bundle, certPEM, 0o600))
+
+	plain, err := New(Options{})
+	require.NoError(t, err)
+	_, err = plain.Get(srv.URL)
+	assert.Error(t, err, "server certificate must not be trusted without the bundle")
+
+	client, err := New(Options{CABundle: bundle})
+	require.NoError(t, err)
+	resp, err := client.Get(srv.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestNew_Proxy(t *testing.T) {
+	client, err := New(Options{Proxy: "http://proxy.internal:3128"})
+	require.NoError(t, err)
+
+	req, _ := http.NewRequest(http.MethodGet, "https://github.example.com/api/graphql", nil)
+	proxyURL, err := client.Transport.(*http.Transport).Proxy(req)
+	require.NoError(t, err)
+	assert.Equal(t, "http://proxy.internal:3128", proxyURL.String())
+}
+
+func TestNew_Errors(t *testing.T) {
+	empty := filepath.Join(t.TempDir(), "empty.pem")
+	require.NoError(t, os.WriteFile(empty, []byte("not a certificate"), 0o600))
+
+	tests := []struct {
+		name string
+		opts Options
+	}{
+		{name: "missing CA bundle", opts: Options{CABundle: filepath.Join(t.TempDir(), "missing.pem")}},
+		{name: "CA bundle without certificates", opts: Options{CABundle: empty}},
+		{name: "invalid proxy URL", opts: Options{Proxy: "://bad"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := New(tt.opts)
+			assert.Error(t, err)
+			assert.Nil(t, client)
+		})
+	}
+}
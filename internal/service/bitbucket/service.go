@@ -0,0 +1,69 @@
+package bitbucket
+
+import (
+	"context"
+
+	domain "hufschlaeger.net/markscribe/internal/domain"
+	forgesvc "hufschlaeger.net/markscribe/internal/service/forge"
+	"hufschlaeger.net/markscribe/internal/usecase/ports"
+)
+
+// Service wraps the BitbucketPort and contains app-level logic for Bitbucket
+// Cloud features. Repositories are listed from a workspace, which defaults to
+// the user's personal workspace. Results pass through a forge.Filter
+// configured by the options given to New.
+type Service struct {
+	bb        ports.BitbucketPort
+	username  string
+	workspace string
+	filter    forgesvc.Filter
+}
+
+func New(bb ports.BitbucketPort, username, workspace string, opts ...forgesvc.FilterOption) *Service {
+	if workspace == "" {
+		workspace = username
+	}
+	return &Service{bb: bb, username: username, workspace: workspace, filter: forgesvc.NewFilter(username, opts...)}
+}
+
+// RecentRepos returns the workspace's most recently created non-fork
+// repositories.
+func (s *Service) RecentRepos(count int) []domain.Repo {
+	return s.recentRepos(count, false)
+}
+
+// RecentForks returns the workspace's most recently created forks.
+func (s *Service) RecentForks(count int) []domain.Repo {
+	return s.recentRepos(count, true)
+}
+
+func (s *Service) recentRepos(count int, isFork bool) []domain.Repo {
+	repos, err := s.bb.RecentRepos(context.Background(), s.workspace, count, isFork, s.filter.Allows)
+	if err != nil {
+		panic(err)
+	}
+	return s.filter.RedactRepos(repos)
+}
+
+// RecentPullRequests returns the user's most recent pull requests to
+// allowed repositories.
+func (s *Service) RecentPullRequests(count int) []domain.PullRequest {
+	prs, err := s.bb.RecentPullRequests(context.Background(), s.username, count, s.filter.Allows)
+	if err != nil {
+		panic(err)
+	}
+	var out []domain.PullRequest
+	for _, pr := range prs {
+		out = append(out, s.filter.RedactPullRequest(pr))
+	}
+	return out
+}
+
+// Workspaces returns the workspaces the user has access to.
+func (s *Service) Workspaces(count int) []domain.User {
+	ws, err := s.bb.Workspaces(context.Background(), count)
+	if err != nil {
+		panic(err)
+	}
+	return ws
+}
@@ -0,0 +1,147 @@
+package bitbucket
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+// keepItems filters items like the adapter does: it skips items whose
+// repository keep rejects and stops at count.
+func keepItems[T any](items []T, count int, keep func(domain.Repo) bool, repo func(T) domain.Repo) []T {
+	var out []T
+	for _, item := range items {
+		if keep != nil && !keep(repo(item)) {
+			continue
+		}
+		if len(out) == count {
+			break
+		}
+		out = append(out, item)
+	}
+	return out
+}
+
+// MockBitbucketPort is a mock implementation of ports.BitbucketPort
+type MockBitbucketPort struct {
+	mock.Mock
+}
+
+func (m *MockBitbucketPort) ViewerLogin(ctx context.Context) (string, error) {
+	args := m.Called(ctx)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockBitbucketPort) RecentRepos(ctx context.Context, workspace string, count int, isFork bool, keep func(domain.Repo) bool) ([]domain.Repo, error) {
+	args := m.Called(ctx, workspace, count, isFork)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return keepItems(args.Get(0).([]domain.Repo), count, keep, func(r domain.Repo) domain.Repo { return r }), args.Error(1)
+}
+
+func (m *MockBitbucketPort) RecentPullRequests(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.PullRequest, error) {
+	args := m.Called(ctx, username, count)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return keepItems(args.Get(0).([]domain.PullRequest), count, keep, func(pr domain.PullRequest) domain.Repo { return pr.Repo }), args.Error(1)
+}
+
+func (m *MockBitbucketPort) Workspaces(ctx context.Context, count int) ([]domain.User, error) {
+	args := m.Called(ctx, count)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.User), args.Error(1)
+}
+
+func TestService_RecentRepos(t *testing.T) {
+	tests := []struct {
+		name           string
+		workspace      string
+		count          int
+		mockRepos      []domain.Repo
+		mockError      error
+		expectedPanic  bool
+		expectedResult []domain.Repo
+	}{
+		{
+			name:  "excludes meta and private repositories",
+			count: 2,
+			mockRepos: []domain.Repo{
+				{Name: "testuser/testuser"},
+				{Name: "testuser/secret", IsPrivate: true},
+				{Name: "testuser/app"},
+				{Name: "testuser/lib"},
+				{Name: "testuser/tool"},
+			},
+			expectedResult: []domain.Repo{{Name: "testuser/app"}, {Name: "testuser/lib"}},
+		},
+		{
+			name:           "lists a team workspace",
+			workspace:      "team",
+			count:          1,
+			mockRepos:      []domain.Repo{{Name: "team/service"}},
+			expectedResult: []domain.Repo{{Name: "team/service"}},
+		},
+		{
+			name:          "panics on error",
+			count:         2,
+			mockError:     errors.New("api error"),
+			expectedPanic: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspace := tt.workspace
+			if workspace == "" {
+				workspace = "testuser"
+			}
+			mockBB := new(MockBitbucketPort)
+			mockBB.On("RecentRepos", mock.Anything, workspace, tt.count, false).
+				Return(tt.mockRepos, tt.mockError)
+
+			svc := New(mockBB, "testuser", tt.workspace)
+
+			if tt.expectedPanic {
+				assert.Panics(t, func() {
+					svc.RecentRepos(tt.count)
+				})
+				return
+			}
+
+			result := svc.RecentRepos(tt.count)
+
+			assert.Equal(t, tt.expectedResult, result)
+			mockBB.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_RecentPullRequests(t *testing.T) {
+	mockBB := new(MockBitbucketPort)
+	mockBB.On("RecentPullRequests", mock.Anything, "testuser", 2).Return([]domain.PullRequest{
+		{Title: "Secret", Repo: domain.Repo{Name: "corp/secret", IsPrivate: true}},
+		{Title: "Fix", Repo: domain.Repo{Name: "other/lib"}},
+	}, nil)
+
+	result := New(mockBB, "testuser", "").RecentPullRequests(2)
+
+	assert.Equal(t, []domain.PullRequest{{Title: "Fix", Repo: domain.Repo{Name: "other/lib"}}}, result)
+	mockBB.AssertExpectations(t)
+}
+
+func TestService_Workspaces(t *testing.T) {
+	mockBB := new(MockBitbucketPort)
+	mockBB.On("Workspaces", mock.Anything, 3).Return(nil, errors.New("api error"))
+
+	assert.Panics(t, func() {
+		New(mockBB, "testuser", "").Workspaces(3)
+	})
+}
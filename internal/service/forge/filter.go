@@ -0,0 +1,43 @@
+package forge
+
+import (
+	"strings"
+
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+// Filter decides which repositories a forge service shows and how private
+// ones appear. It skips the user's profile meta repo "username/username",
+// which only holds the profile README, and repositories rejected by the Rules
+// passed via WithRules. Private repositories are excluded, redacted or
+// included according to the Privacy mode passed via WithPrivacy,
+// PrivacyExclude by default. Sourcehut's "~" owner prefix is ignored.
+type Filter struct {
+	username string
+	rules    Rules
+	privacy  Privacy
+	aliases  map[string]string
+}
+
+// FilterOption configures a Filter.
+type FilterOption func(*Filter)
+
+func NewFilter(username string, opts ...FilterOption) Filter {
+	f := Filter{username: strings.TrimPrefix(username, "~"), privacy: PrivacyExclude}
+	for _, opt := range opts {
+		opt(&f)
+	}
+	return f
+}
+
+// Allows reports whether repo may appear in results.
+func (f Filter) Allows(repo domain.Repo) bool {
+	repo.Name = strings.TrimPrefix(repo.Name, "~")
+	if strings.EqualFold(repo.Name, f.username+"/"+f.username) {
+		return false
+	}
+	if repo.IsPrivate && f.privacy == PrivacyExclude {
+		return false
+	}
+	return f.rules.Allows(repo)
+}
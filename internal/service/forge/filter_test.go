@@ -0,0 +1,29 @@
+package forge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+func TestFilter_Allows(t *testing.T) {
+	tests := []struct {
+		name     string
+		username string
+		repo     domain.Repo
+		expected bool
+	}{
+		{name: "public repository", username: "me", repo: domain.Repo{Name: "me/app"}, expected: true},
+		{name: "private repository", username: "me", repo: domain.Repo{Name: "me/app", IsPrivate: true}, expected: false},
+		{name: "meta repo", username: "me", repo: domain.Repo{Name: "Me/me"}, expected: false},
+		{name: "sourcehut meta repo", username: "~me", repo: domain.Repo{Name: "~me/me"}, expected: false},
+		{name: "other user's meta repo", username: "me", repo: domain.Repo{Name: "you/you"}, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NewFilter(tt.username).Allows(tt.repo))
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package forge
+
+import (
+	"fmt"
+	"strings"
+
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+// Privacy controls how private repositories appear in results.
+type Privacy string
+
+const (
+	// PrivacyExclude drops everything related to private repositories.
+	PrivacyExclude Privacy = "exclude"
+	// PrivacyRedact keeps private activity but hides repository names, URLs
+	// and titles, unless an alias is configured for the repository.
+	PrivacyRedact Privacy = "redact"
+	// PrivacyInclude shows private repositories like public ones.
+	PrivacyInclude Privacy = "include"
+)
+
+// RedactedRepoName replaces the name of private repositories without alias.
+const RedactedRepoName = "a private repo"
+
+// ParsePrivacy parses a privacy mode. The empty string means PrivacyExclude.
+func ParsePrivacy(s string) (Privacy, error) {
+	switch p := Privacy(strings.ToLower(strings.TrimSpace(s))); p {
+	case "":
+		return PrivacyExclude, nil
+	case PrivacyExclude, PrivacyRedact, PrivacyInclude:
+		return p, nil
+	default:
+		return "", fmt.Errorf("unknown privacy mode %q, expected include, redact or exclude", s)
+	}
+}
+
+// WithPrivacy sets the privacy mode. In PrivacyRedact mode, aliases maps
+// "owner/name" of private repositories to the name shown instead.
+func WithPrivacy(p Privacy, aliases map[string]string) FilterOption {
+	return func(f *Filter) {
+		f.privacy = p
+		f.aliases = make(map[string]string, len(aliases))
+		for name, alias := range aliases {
+			f.aliases[strings.ToLower(name)] = alias
+		}
+	}
+}
+
+// Redact hides the identity of a private repository in PrivacyRedact mode.
+func (f Filter) Redact(r domain.Repo) domain.Repo {
+	if !f.Redacts(r) {
+		return r
+	}
+	name := RedactedRepoName
+	if alias, ok := f.aliases[strings.ToLower(strings.TrimPrefix(r.Name, "~"))]; ok {
+		name = alias
+	}
+	return domain.Repo{
+		Name:        name,
+		IsPrivate:   true,
+		Stargazers:  r.Stargazers,
+		LastRelease: domain.Release{PublishedAt: r.LastRelease.PublishedAt},
+		CreatedAt:   r.CreatedAt,
+		PushedAt:    r.PushedAt,
+		Forge:       r.Forge,
+	}
+}
+
+// RedactRepos applies Redact to each repository.
+func (f Filter) RedactRepos(repos []domain.Repo) []domain.Repo {
+	var out []domain.Repo
+	for _, r := range repos {
+		out = append(out, f.Redact(r))
+	}
+	return out
+}
+
+// Redacts reports whether items of r must hide titles and URLs.
+func (f Filter) Redacts(r domain.Repo) bool {
+	return r.IsPrivate && f.privacy == PrivacyRedact
+}
+
+// RedactPullRequest hides the title and URL of a pull request to a private
+// repository in PrivacyRedact mode.
+func (f Filter) RedactPullRequest(pr domain.PullRequest) domain.PullRequest {
+	if f.Redacts(pr.Repo) {
+		pr.Title, pr.URL, pr.Labels = "", "", nil
+	}
+	pr.Repo = f.Redact(pr.Repo)
+	return pr
+}
+
+// RedactIssue hides the title and URL of an issue in a private repository in
+// PrivacyRedact mode.
+func (f Filter) RedactIssue(is domain.Issue) domain.Issue {
+	if f.Redacts(is.Repo) {
+		is.Title, is.URL, is.Labels = "", "", nil
+	}
+	is.Repo = f.Redact(is.Repo)
+	return is
+}
@@ -0,0 +1,32 @@
+package forge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePrivacy(t *testing.T) {
+	tests := []struct {
+		in        string
+		expected  Privacy
+		expectErr bool
+	}{
+		{in: "", expected: PrivacyExclude},
+		{in: "Redact", expected: PrivacyRedact},
+		{in: "include", expected: PrivacyInclude},
+		{in: "hide", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			p, err := ParsePrivacy(tt.in)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, p)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package forge
+
+import (
+	"path"
+	"strings"
+
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+// Rules decides which repositories may appear in results. Names and owners
+// are compared case-insensitively. The zero value allows every repository.
+type Rules struct {
+	// Include, when non-empty, restricts results to repositories whose
+	// "owner/name" matches one of the glob patterns (e.g. "our-org/*").
+	Include []string
+	// Exclude drops repositories whose "owner/name" matches one of the glob
+	// patterns (e.g. "our-org/internal-*").
+	Exclude []string
+	// ExcludeTopics drops repositories tagged with any of the topics.
+	ExcludeTopics []string
+	// ExcludeArchived drops archived repositories.
+	ExcludeArchived bool
+	// ExcludeForks drops forked repositories.
+	ExcludeForks bool
+	// AllowOwners, when non-empty, restricts results to repositories owned by
+	// one of the users or organizations.
+	AllowOwners []string
+	// DenyOwners drops repositories owned by one of the users or organizations.
+	DenyOwners []string
+}
+
+// Allows reports whether repo passes the rules.
+func (r Rules) Allows(repo domain.Repo) bool {
+	name := strings.ToLower(repo.Name)
+	owner, _, _ := strings.Cut(name, "/")
+
+	if len(r.Include) > 0 && !matchesAny(r.Include, name) {
+		return false
+	}
+	if matchesAny(r.Exclude, name) {
+		return false
+	}
+	if r.ExcludeArchived && repo.IsArchived {
+		return false
+	}
+	if r.ExcludeForks && repo.IsFork {
+		return false
+	}
+	if len(r.AllowOwners) > 0 && !containsFold(r.AllowOwners, owner) {
+		return false
+	}
+	if containsFold(r.DenyOwners, owner) {
+		return false
+	}
+	for _, t := range repo.Topics {
+		if containsFold(r.ExcludeTopics, t) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesAny reports whether name matches one of the glob patterns.
+// Malformed patterns never match.
+func matchesAny(patterns []string, name string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(strings.ToLower(p), name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// WithRules applies rules to every repository the filter sees.
+func WithRules(rules Rules) FilterOption {
+	return func(f *Filter) { f.rules = rules }
+}
@@ -0,0 +1,83 @@
+package forge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+func TestRules_Allows(t *testing.T) {
+	tests := []struct {
+		name     string
+		rules    Rules
+		repo     domain.Repo
+		expected bool
+	}{
+		{
+			name:     "zero value allows everything",
+			repo:     domain.Repo{Name: "org/repo", IsArchived: true, IsFork: true},
+			expected: true,
+		},
+		{
+			name:     "exclude glob",
+			rules:    Rules{Exclude: []string{"our-org/internal-*"}},
+			repo:     domain.Repo{Name: "Our-Org/Internal-Tools"},
+			expected: false,
+		},
+		{
+			name:     "exclude glob does not match other repos",
+			rules:    Rules{Exclude: []string{"our-org/internal-*"}},
+			repo:     domain.Repo{Name: "our-org/public-api"},
+			expected: true,
+		},
+		{
+			name:     "include restricts to matches",
+			rules:    Rules{Include: []string{"our-org/*"}},
+			repo:     domain.Repo{Name: "someone/else"},
+			expected: false,
+		},
+		{
+			name:     "excluded topic",
+			rules:    Rules{ExcludeTopics: []string{"playground"}},
+			repo:     domain.Repo{Name: "me/test", Topics: []string{"go", "Playground"}},
+			expected: false,
+		},
+		{
+			name:     "archived",
+			rules:    Rules{ExcludeArchived: true},
+			repo:     domain.Repo{Name: "me/old", IsArchived: true},
+			expected: false,
+		},
+		{
+			name:     "fork",
+			rules:    Rules{ExcludeForks: true},
+			repo:     domain.Repo{Name: "me/fork", IsFork: true},
+			expected: false,
+		},
+		{
+			name:     "owner not in allow list",
+			rules:    Rules{AllowOwners: []string{"me", "our-org"}},
+			repo:     domain.Repo{Name: "other/repo"},
+			expected: false,
+		},
+		{
+			name:     "owner in allow list",
+			rules:    Rules{AllowOwners: []string{"me", "our-org"}},
+			repo:     domain.Repo{Name: "OUR-ORG/repo"},
+			expected: true,
+		},
+		{
+			name:     "owner in deny list",
+			rules:    Rules{DenyOwners: []string{"test-org"}},
+			repo:     domain.Repo{Name: "test-org/repo"},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.rules.Allows(tt.repo))
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package forge
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+// Forge names set in domain.Repo.Forge.
+const (
+	GitHub    = "github"
+	GitLab    = "gitlab"
+	Forgejo   = "forgejo"
+	Bitbucket = "bitbucket"
+	Sourcehut = "sourcehut"
+)
+
+// Source is one configured forge. Its functions are usually methods of the
+// forge's service, which applies its own filtering; a nil function means the
+// forge has no such listing.
+type Source struct {
+	Forge        string
+	Repos        func(count int) []domain.Repo
+	Forks        func(count int) []domain.Repo
+	Releases     func(count int) []domain.Repo
+	PullRequests func(count int) []domain.PullRequest
+}
+
+// Service merges results from several forges, so templates don't have to
+// care where a repository lives. Repositories are tagged with their forge;
+// mirrors of the same repository on several forges, recognised by name or by
+// the upstream URL a forge reports for them, are reported once, from the
+// forge registered first.
+type Service struct {
+	sources []Source
+}
+
+func New(sources ...Source) *Service {
+	return &Service{sources: sources}
+}
+
+// Forges returns the names of the configured forges.
+func (s *Service) Forges() []string {
+	out := make([]string, 0, len(s.sources))
+	for _, src := range s.sources {
+		out = append(out, src.Forge)
+	}
+	return out
+}
+
+// Repos returns the most recently created non-fork repositories across all
+// forges.
+func (s *Service) Repos(count int) []domain.Repo {
+	out := s.mergeRepos(count, func(src Source) func(int) []domain.Repo { return src.Repos })
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].CreatedAt.After(out[j].CreatedAt)
+	})
+	return trim(out, count)
+}
+
+// Forks returns the most recently created forks across all forges.
+func (s *Service) Forks(count int) []domain.Repo {
+	out := s.mergeRepos(count, func(src Source) func(int) []domain.Repo { return src.Forks })
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].CreatedAt.After(out[j].CreatedAt)
+	})
+	return trim(out, count)
+}
+
+// Releases returns the repositories with the most recent releases across all
+// forges.
+func (s *Service) Releases(count int) []domain.Repo {
+	out := s.mergeRepos(count, func(src Source) func(int) []domain.Repo { return src.Releases })
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].LastRelease.PublishedAt.After(out[j].LastRelease.PublishedAt)
+	})
+	return trim(out, count)
+}
+
+// PullRequests returns the most recent pull and merge requests across all
+// forges.
+func (s *Service) PullRequests(count int) []domain.PullRequest {
+	var out []domain.PullRequest
+	for _, src := range s.sources {
+		if src.PullRequests == nil {
+			continue
+		}
+		for _, pr := range src.PullRequests(count) {
+			pr.Repo.Forge = src.Forge
+			out = append(out, pr)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].CreatedAt.After(out[j].CreatedAt)
+	})
+	if len(out) > count {
+		out = out[:count]
+	}
+	return out
+}
+
+// mergeRepos collects up to count repositories from every forge providing
+// the listing selected by list, tags them and drops mirrors.
+func (s *Service) mergeRepos(count int, list func(Source) func(int) []domain.Repo) []domain.Repo {
+	seen := map[string]bool{}
+	var out []domain.Repo
+	for _, src := range s.sources {
+		fn := list(src)
+		if fn == nil {
+			continue
+		}
+		for _, r := range fn(count) {
+			r.Forge = src.Forge
+			// Redacted private repositories share a placeholder name and
+			// have no URL, so they are never treated as mirrors.
+			if !r.IsPrivate {
+				keys := mirrorKeys(r)
+				if anySeen(seen, keys) {
+					continue
+				}
+				for _, k := range keys {
+					seen[k] = true
+				}
+			}
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// mirrorKeys returns the keys identifying a repository across forges: its
+// name, ignoring case and sourcehut's "~" owner prefix, its URL and, for a
+// mirror, its upstream URL, which is the URL of the original elsewhere.
+func mirrorKeys(r domain.Repo) []string {
+	keys := []string{"name:" + strings.ToLower(strings.TrimPrefix(r.Name, "~"))}
+	for _, u := range []string{r.URL, r.MirrorURL} {
+		if u != "" {
+			keys = append(keys, "url:"+normalizeURL(u))
+		}
+	}
+	return keys
+}
+
+// normalizeURL reduces a repository URL to host and path, so web and clone
+// URLs of the same repository compare equal.
+func normalizeURL(rawURL string) string {
+	u, err := url.Parse(strings.ToLower(rawURL))
+	if err != nil || u.Host == "" {
+		return strings.ToLower(rawURL)
+	}
+	return u.Host + strings.TrimSuffix(strings.TrimSuffix(u.Path, "/"), ".git")
+}
+
+func anySeen(seen map[string]bool, keys []string) bool {
+	for _, k := range keys {
+		if seen[k] {
+			return true
+		}
+	}
+	return false
+}
+
+func trim(repos []domain.Repo, count int) []domain.Repo {
+	if len(repos) > count {
+		return repos[:count]
+	}
+	return repos
+}
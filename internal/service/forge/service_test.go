@@ -0,0 +1,139 @@
+package forge
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+// list returns a listing function serving up to count of repos.
+func list(repos ...domain.Repo) func(int) []domain.Repo {
+	return func(count int) []domain.Repo {
+		if len(repos) > count {
+			return repos[:count]
+		}
+		return repos
+	}
+}
+
+func TestService_Repos(t *testing.T) {
+	day := func(n int) time.Time { return time.Date(2025, 1, n, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name           string
+		count          int
+		sources        []Source
+		expectedResult []domain.Repo
+	}{
+		{
+			name:  "merges and sorts by creation date",
+			count: 3,
+			sources: []Source{
+				{Forge: GitHub, Repos: list(
+					domain.Repo{Name: "me/a", CreatedAt: day(5)},
+					domain.Repo{Name: "me/b", CreatedAt: day(1)},
+				)},
+				{Forge: GitLab, Repos: list(
+					domain.Repo{Name: "me/c", CreatedAt: day(3)},
+					domain.Repo{Name: "me/d", CreatedAt: day(2)},
+				)},
+			},
+			expectedResult: []domain.Repo{
+				{Name: "me/a", CreatedAt: day(5), Forge: GitHub},
+				{Name: "me/c", CreatedAt: day(3), Forge: GitLab},
+				{Name: "me/d", CreatedAt: day(2), Forge: GitLab},
+			},
+		},
+		{
+			name:  "keeps mirrors from the first forge only",
+			count: 5,
+			sources: []Source{
+				{Forge: GitHub, Repos: list(domain.Repo{Name: "me/app", URL: "https://github.com/me/app", CreatedAt: day(1)})},
+				{Forge: Forgejo, Repos: list(
+					domain.Repo{Name: "Me/App", URL: "https://codeberg.org/Me/App", CreatedAt: day(4)},
+					domain.Repo{Name: "mirrors/app", URL: "https://codeberg.org/mirrors/app", MirrorURL: "https://github.com/me/app.git", CreatedAt: day(3)},
+				)},
+				{Forge: Sourcehut, Repos: list(domain.Repo{Name: "~me/app", URL: "https://git.sr.ht/~me/app", CreatedAt: day(2)})},
+			},
+			expectedResult: []domain.Repo{{Name: "me/app", URL: "https://github.com/me/app", CreatedAt: day(1), Forge: GitHub}},
+		},
+		{
+			name:  "drops originals of mirrors from an earlier forge",
+			count: 5,
+			sources: []Source{
+				{Forge: GitLab, Repos: list(domain.Repo{Name: "group/tool", URL: "https://gitlab.com/group/tool", MirrorURL: "https://codeberg.org/me/tool.git", CreatedAt: day(2)})},
+				{Forge: Forgejo, Repos: list(
+					domain.Repo{Name: "me/tool", URL: "https://codeberg.org/me/tool", CreatedAt: day(3)},
+					domain.Repo{Name: "me/other", URL: "https://codeberg.org/me/other", CreatedAt: day(1)},
+				)},
+			},
+			expectedResult: []domain.Repo{
+				{Name: "group/tool", URL: "https://gitlab.com/group/tool", MirrorURL: "https://codeberg.org/me/tool.git", CreatedAt: day(2), Forge: GitLab},
+				{Name: "me/other", URL: "https://codeberg.org/me/other", CreatedAt: day(1), Forge: Forgejo},
+			},
+		},
+		{
+			name:  "does not dedupe redacted private repositories",
+			count: 5,
+			sources: []Source{
+				{Forge: GitHub, Repos: list(
+					domain.Repo{Name: "a private repo", IsPrivate: true, CreatedAt: day(2)},
+					domain.Repo{Name: "a private repo", IsPrivate: true, CreatedAt: day(1)},
+				)},
+			},
+			expectedResult: []domain.Repo{
+				{Name: "a private repo", IsPrivate: true, CreatedAt: day(2), Forge: GitHub},
+				{Name: "a private repo", IsPrivate: true, CreatedAt: day(1), Forge: GitHub},
+			},
+		},
+		{
+			name:           "skips forges without the listing",
+			count:          5,
+			sources:        []Source{{Forge: Bitbucket}},
+			expectedResult: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := New(tt.sources...)
+
+			result := svc.Repos(tt.count)
+
+			assert.Equal(t, tt.expectedResult, result)
+		})
+	}
+}
+
+func TestService_Releases(t *testing.T) {
+	now := time.Now()
+	svc := New(
+		Source{Forge: GitHub, Releases: list(domain.Repo{Name: "me/a", LastRelease: domain.Release{PublishedAt: now.Add(-time.Hour)}})},
+		Source{Forge: Forgejo, Releases: list(domain.Repo{Name: "me/b", LastRelease: domain.Release{PublishedAt: now}})},
+	)
+
+	assert.Equal(t, []domain.Repo{
+		{Name: "me/b", LastRelease: domain.Release{PublishedAt: now}, Forge: Forgejo},
+	}, svc.Releases(1))
+}
+
+func TestService_PullRequests(t *testing.T) {
+	now := time.Now()
+	svc := New(
+		Source{Forge: GitHub, PullRequests: func(count int) []domain.PullRequest {
+			return []domain.PullRequest{{Title: "old", CreatedAt: now.Add(-time.Hour)}}
+		}},
+		Source{Forge: Sourcehut},
+		Source{Forge: GitLab, PullRequests: func(count int) []domain.PullRequest {
+			return []domain.PullRequest{{Title: "new", CreatedAt: now}}
+		}},
+	)
+
+	assert.Equal(t, []domain.PullRequest{
+		{Title: "new", CreatedAt: now, Repo: domain.Repo{Forge: GitLab}},
+		{Title: "old", CreatedAt: now.Add(-time.Hour), Repo: domain.Repo{Forge: GitHub}},
+	}, svc.PullRequests(5))
+	assert.Equal(t, []string{GitHub, Sourcehut, GitLab}, svc.Forges())
+}
@@ -0,0 +1,98 @@
+package forgejo
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	domain "hufschlaeger.net/markscribe/internal/domain"
+	forgesvc "hufschlaeger.net/markscribe/internal/service/forge"
+	"hufschlaeger.net/markscribe/internal/usecase/ports"
+)
+
+// Service wraps the ForgejoPort and contains app-level logic for Forgejo and
+// Gitea features. Results pass through a forge.Filter configured by the
+// options given to New.
+type Service struct {
+	fj       ports.ForgejoPort
+	username string
+	filter   forgesvc.Filter
+	now      func() time.Time
+}
+
+func New(fj ports.ForgejoPort, username string, opts ...forgesvc.FilterOption) *Service {
+	return &Service{fj: fj, username: username, filter: forgesvc.NewFilter(username, opts...), now: time.Now}
+}
+
+// RecentRepos returns the user's most recently created non-fork repositories.
+func (s *Service) RecentRepos(count int) []domain.Repo {
+	return s.recentRepos(count, false)
+}
+
+// RecentForks returns the user's most recently created forks.
+func (s *Service) RecentForks(count int) []domain.Repo {
+	return s.recentRepos(count, true)
+}
+
+func (s *Service) recentRepos(count int, isFork bool) []domain.Repo {
+	repos, err := s.fj.RecentRepos(context.Background(), s.username, count, isFork, s.filter.Allows)
+	if err != nil {
+		panic(err)
+	}
+	return s.filter.RedactRepos(repos)
+}
+
+// RecentReleases returns repositories with the most recent releases, sorted by
+// PublishedAt desc, then Stargazers desc, limited to count.
+func (s *Service) RecentReleases(count int) []domain.Repo {
+	repos, err := s.fj.RecentReleases(context.Background(), s.username, count, s.filter.Allows)
+	if err != nil {
+		panic(err)
+	}
+	repos = s.filter.RedactRepos(repos)
+	sort.SliceStable(repos, func(i, j int) bool {
+		if repos[i].LastRelease.PublishedAt.Equal(repos[j].LastRelease.PublishedAt) {
+			return repos[i].Stargazers > repos[j].Stargazers
+		}
+		return repos[i].LastRelease.PublishedAt.After(repos[j].LastRelease.PublishedAt)
+	})
+	return repos
+}
+
+// RecentStars returns repositories starred by the user, limited to count.
+func (s *Service) RecentStars(count int) []domain.Star {
+	stars, err := s.fj.RecentStars(context.Background(), s.username, count, s.filter.Allows)
+	if err != nil {
+		panic(err)
+	}
+	var out []domain.Star
+	for _, st := range stars {
+		st.Repo = s.filter.Redact(st.Repo)
+		out = append(out, st)
+	}
+	return out
+}
+
+// ContributionDays returns the user's contributions for each of the last
+// days (including today, UTC), oldest first. Days without contributions are
+// included with a zero count, so the result can be rendered as a heatmap.
+func (s *Service) ContributionDays(days int) []domain.ContributionDay {
+	if days <= 0 {
+		return nil
+	}
+	today := s.now().UTC().Truncate(24 * time.Hour)
+	since := today.AddDate(0, 0, -(days - 1))
+	counts, err := s.fj.ContributionDays(context.Background(), s.username, since)
+	if err != nil {
+		panic(err)
+	}
+	byDay := map[time.Time]int{}
+	for _, c := range counts {
+		byDay[c.Date.UTC().Truncate(24*time.Hour)] += c.Count
+	}
+	out := make([]domain.ContributionDay, 0, days)
+	for d := since; !d.After(today); d = d.AddDate(0, 0, 1) {
+		out = append(out, domain.ContributionDay{Date: d, Count: byDay[d]})
+	}
+	return out
+}
@@ -0,0 +1,169 @@
+package forgejo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+// keepItems filters items like the adapter does: it skips items whose
+// repository keep rejects and stops at count.
+func keepItems[T any](items []T, count int, keep func(domain.Repo) bool, repo func(T) domain.Repo) []T {
+	var out []T
+	for _, item := range items {
+		if keep != nil && !keep(repo(item)) {
+			continue
+		}
+		if len(out) == count {
+			break
+		}
+		out = append(out, item)
+	}
+	return out
+}
+
+// MockForgejoPort is a mock implementation of ports.ForgejoPort
+type MockForgejoPort struct {
+	mock.Mock
+}
+
+func (m *MockForgejoPort) ViewerLogin(ctx context.Context) (string, error) {
+	args := m.Called(ctx)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockForgejoPort) RecentRepos(ctx context.Context, username string, count int, isFork bool, keep func(domain.Repo) bool) ([]domain.Repo, error) {
+	args := m.Called(ctx, username, count, isFork)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return keepItems(args.Get(0).([]domain.Repo), count, keep, func(r domain.Repo) domain.Repo { return r }), args.Error(1)
+}
+
+func (m *MockForgejoPort) RecentReleases(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Repo, error) {
+	args := m.Called(ctx, username, count)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return keepItems(args.Get(0).([]domain.Repo), count, keep, func(r domain.Repo) domain.Repo { return r }), args.Error(1)
+}
+
+func (m *MockForgejoPort) RecentStars(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Star, error) {
+	args := m.Called(ctx, username, count)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return keepItems(args.Get(0).([]domain.Star), count, keep, func(st domain.Star) domain.Repo { return st.Repo }), args.Error(1)
+}
+
+func (m *MockForgejoPort) ContributionDays(ctx context.Context, username string, since time.Time) ([]domain.ContributionDay, error) {
+	args := m.Called(ctx, username, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.ContributionDay), args.Error(1)
+}
+
+func TestService_RecentRepos(t *testing.T) {
+	tests := []struct {
+		name           string
+		count          int
+		mockRepos      []domain.Repo
+		mockError      error
+		expectedPanic  bool
+		expectedResult []domain.Repo
+	}{
+		{
+			name:  "excludes meta and private repositories",
+			count: 2,
+			mockRepos: []domain.Repo{
+				{Name: "testuser/testuser"},
+				{Name: "testuser/secret", IsPrivate: true},
+				{Name: "testuser/app"},
+				{Name: "testuser/lib"},
+				{Name: "testuser/tool"},
+			},
+			expectedResult: []domain.Repo{{Name: "testuser/app"}, {Name: "testuser/lib"}},
+		},
+		{
+			name:          "panics on error",
+			count:         2,
+			mockError:     errors.New("api error"),
+			expectedPanic: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockFJ := new(MockForgejoPort)
+			mockFJ.On("RecentRepos", mock.Anything, "testuser", tt.count, false).
+				Return(tt.mockRepos, tt.mockError)
+
+			svc := New(mockFJ, "testuser")
+
+			if tt.expectedPanic {
+				assert.Panics(t, func() {
+					svc.RecentRepos(tt.count)
+				})
+				return
+			}
+
+			result := svc.RecentRepos(tt.count)
+
+			assert.Equal(t, tt.expectedResult, result)
+			mockFJ.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_RecentReleases(t *testing.T) {
+	now := time.Now()
+	mockFJ := new(MockForgejoPort)
+	mockFJ.On("RecentReleases", mock.Anything, "testuser", 2).Return([]domain.Repo{
+		{Name: "testuser/a", LastRelease: domain.Release{PublishedAt: now.Add(-time.Hour)}},
+		{Name: "testuser/secret", IsPrivate: true, LastRelease: domain.Release{PublishedAt: now}},
+		{Name: "testuser/b", LastRelease: domain.Release{PublishedAt: now}},
+	}, nil)
+
+	result := New(mockFJ, "testuser").RecentReleases(2)
+
+	assert.Equal(t, []domain.Repo{
+		{Name: "testuser/b", LastRelease: domain.Release{PublishedAt: now}},
+		{Name: "testuser/a", LastRelease: domain.Release{PublishedAt: now.Add(-time.Hour)}},
+	}, result)
+	mockFJ.AssertExpectations(t)
+}
+
+func TestService_RecentStars(t *testing.T) {
+	mockFJ := new(MockForgejoPort)
+	mockFJ.On("RecentStars", mock.Anything, "testuser", 2).Return([]domain.Star{
+		{Repo: domain.Repo{Name: "other/lib"}},
+	}, nil)
+
+	assert.Equal(t, []domain.Star{{Repo: domain.Repo{Name: "other/lib"}}}, New(mockFJ, "testuser").RecentStars(2))
+	mockFJ.AssertExpectations(t)
+}
+
+func TestService_ContributionDays(t *testing.T) {
+	day := func(n int) time.Time { return time.Date(2025, 1, n, 0, 0, 0, 0, time.UTC) }
+
+	mockFJ := new(MockForgejoPort)
+	mockFJ.On("ContributionDays", mock.Anything, "testuser", day(8)).Return([]domain.ContributionDay{
+		{Date: day(9), Count: 4},
+	}, nil)
+
+	svc := New(mockFJ, "testuser")
+	svc.now = func() time.Time { return time.Date(2025, 1, 10, 15, 0, 0, 0, time.UTC) }
+
+	assert.Equal(t, []domain.ContributionDay{
+		{Date: day(8)},
+		{Date: day(9), Count: 4},
+		{Date: day(10)},
+	}, svc.ContributionDays(3))
+	mockFJ.AssertExpectations(t)
+}
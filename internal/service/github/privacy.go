@@ -0,0 +1,29 @@
+package github
+
+import (
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+func (s *Service) redactCommit(c domain.Commit) domain.Commit {
+	if s.filter.Redacts(c.Repo) {
+		c.Message, c.SHA, c.URL = "", "", ""
+	}
+	c.Repo = s.filter.Redact(c.Repo)
+	return c
+}
+
+func (s *Service) redactDiscussion(d domain.Discussion) domain.Discussion {
+	if s.filter.Redacts(d.Repo) {
+		d.Title, d.URL, d.Category = "", "", ""
+	}
+	d.Repo = s.filter.Redact(d.Repo)
+	return d
+}
+
+func (s *Service) redactReview(r domain.Review) domain.Review {
+	if s.filter.Redacts(r.PullRequest.Repo) {
+		r.URL = ""
+	}
+	r.PullRequest = s.filter.RedactPullRequest(r.PullRequest)
+	return r
+}
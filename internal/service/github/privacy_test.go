@@ -0,0 +1,70 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	domain "hufschlaeger.net/markscribe/internal/domain"
+	forgesvc "hufschlaeger.net/markscribe/internal/service/forge"
+)
+
+func TestService_Privacy(t *testing.T) {
+	prs := []domain.PullRequest{
+		{Title: "Add SSO", URL: "https://github.com/corp/secret/pull/1", Labels: []string{"auth"}, Repo: domain.Repo{Name: "corp/secret", URL: "https://github.com/corp/secret", IsPrivate: true}},
+		{Title: "Bump deps", URL: "https://github.com/corp/billing/pull/2", Repo: domain.Repo{Name: "corp/billing", URL: "https://github.com/corp/billing", Description: "Invoices", IsPrivate: true}},
+		{Title: "Fix typo", URL: "https://github.com/oss/lib/pull/3", Repo: domain.Repo{Name: "oss/lib", URL: "https://github.com/oss/lib"}},
+	}
+
+	tests := []struct {
+		name     string
+		privacy  forgesvc.Privacy
+		expected []domain.PullRequest
+	}{
+		{
+			name:     "exclude",
+			privacy:  forgesvc.PrivacyExclude,
+			expected: []domain.PullRequest{prs[2]},
+		},
+		{
+			name:     "include",
+			privacy:  forgesvc.PrivacyInclude,
+			expected: prs,
+		},
+		{
+			name:    "redact",
+			privacy: forgesvc.PrivacyRedact,
+			expected: []domain.PullRequest{
+				{Repo: domain.Repo{Name: forgesvc.RedactedRepoName, IsPrivate: true}},
+				{Repo: domain.Repo{Name: "Billing service", IsPrivate: true}},
+				prs[2],
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGH := new(MockGithubPort)
+			mockGH.On("RecentPullRequests", mock.Anything, "testuser", 3, []string(nil)).Return(prs, nil)
+
+			svc := New(mockGH, "testuser", WithFilter(forgesvc.WithPrivacy(tt.privacy, map[string]string{"Corp/Billing": "Billing service"})))
+
+			assert.Equal(t, tt.expected, svc.RecentPullRequests(3))
+			mockGH.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_PrivacyStars(t *testing.T) {
+	mockGH := new(MockGithubPort)
+	mockGH.On("RecentStars", mock.Anything, "testuser", 5).Return([]domain.Star{
+		{Repo: domain.Repo{Name: "corp/secret", URL: "https://github.com/corp/secret", IsPrivate: true}},
+		{Repo: domain.Repo{Name: "oss/lib"}},
+	}, nil)
+
+	assert.Equal(t, []domain.Star{{Repo: domain.Repo{Name: "oss/lib"}}}, New(mockGH, "testuser").RecentStars(5))
+	assert.Equal(t, []domain.Star{
+		{Repo: domain.Repo{Name: forgesvc.RedactedRepoName, IsPrivate: true}},
+		{Repo: domain.Repo{Name: "oss/lib"}},
+	}, New(mockGH, "testuser", WithFilter(forgesvc.WithPrivacy(forgesvc.PrivacyRedact, nil))).RecentStars(5))
+}
@@ -3,62 +3,138 @@ package github
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	domain "hufschlaeger.net/markscribe/internal/domain"
+	forgesvc "hufschlaeger.net/markscribe/internal/service/forge"
 	"hufschlaeger.net/markscribe/internal/usecase/ports"
 )
 
 // Service wraps the GithubPort and contains app-level logic for GitHub features.
+// Results referring to repositories pass through the forge.Filter configured via
+// WithFilter; explicitly requested repositories (Repo, Releases, ...) are not filtered.
 type Service struct {
 	gh       ports.GithubPort
 	username string
+	filter   forgesvc.Filter
+	traffic  ports.TrafficHistoryPort
 }
 
-func New(gh ports.GithubPort, username string) *Service {
-	return &Service{gh: gh, username: username}
+func New(gh ports.GithubPort, username string, opts ...Option) *Service {
+	s := &Service{gh: gh, username: username, filter: forgesvc.NewFilter(username)}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Option configures a Service.
+type Option func(*Service)
+
+// WithFilter configures the filter applied to every repository-related result,
+// e.g. with forge.WithRules and forge.WithPrivacy.
+func WithFilter(opts ...forgesvc.FilterOption) Option {
+	return func(s *Service) { s.filter = forgesvc.NewFilter(s.username, opts...) }
+}
+
+// Username returns the configured user. It is empty when neither credentials
+// nor a username were given.
+func (s *Service) Username() string {
+	return s.username
 }
 
 // RecentRepos returns the most recent non-fork repositories owned by the user,
 // excluding the meta repo "username/username".
 func (s *Service) RecentRepos(count int) []domain.Repo {
-	repos, err := s.gh.RecentRepos(context.Background(), s.username, count+1, false)
+	repos, err := s.gh.RecentRepos(context.Background(), s.username, count, false, s.filter.Allows)
 	if err != nil {
 		panic(err)
 	}
-	var out []domain.Repo
-	for _, r := range repos {
-		if r.Name == fmt.Sprintf("%s/%s", s.username, s.username) {
-			continue
-		}
-		out = append(out, r)
-		if len(out) == count {
-			break
-		}
-	}
-	return out
+	return s.filter.RedactRepos(repos)
 }
 
 // RecentForks returns the most recent forked repositories for the user,
 // excluding the meta repo "username/username".
 func (s *Service) RecentForks(count int) []domain.Repo {
-	repos, err := s.gh.RecentRepos(context.Background(), s.username, count+1, true)
+	repos, err := s.gh.RecentRepos(context.Background(), s.username, count, true, s.filter.Allows)
+	if err != nil {
+		panic(err)
+	}
+	return s.filter.RedactRepos(repos)
+}
+
+// TopRepos returns the user's most starred non-fork repositories,
+// excluding the meta repo "username/username".
+func (s *Service) TopRepos(count int) []domain.Repo {
+	repos, err := s.gh.TopRepos(context.Background(), s.username, count, s.filter.Allows)
+	if err != nil {
+		panic(err)
+	}
+	return s.filter.RedactRepos(repos)
+}
+
+// TrendingRepos returns the user's repositories that gained the most stars
+// within the given window (e.g. "7d", "2w", "36h"), sorted by StargazersDelta desc,
+// then Stargazers desc. Repositories without new stars and the meta repo are excluded.
+func (s *Service) TrendingRepos(count int, window string) []domain.Repo {
+	d, err := parseWindow(window)
+	if err != nil {
+		panic(err)
+	}
+	repos, err := s.gh.TrendingRepos(context.Background(), s.username, time.Now().Add(-d))
 	if err != nil {
 		panic(err)
 	}
 	var out []domain.Repo
 	for _, r := range repos {
-		if r.Name == fmt.Sprintf("%s/%s", s.username, s.username) {
+		if !s.filter.Allows(r) {
 			continue
 		}
-		out = append(out, r)
-		if len(out) == count {
-			break
+		if r.StargazersDelta == 0 {
+			continue
+		}
+		out = append(out, s.filter.Redact(r))
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].StargazersDelta == out[j].StargazersDelta {
+			return out[i].Stargazers > out[j].Stargazers
 		}
+		return out[i].StargazersDelta > out[j].StargazersDelta
+	})
+	if len(out) > count {
+		out = out[:count]
 	}
 	return out
 }
 
+// parseWindow parses a time window. In addition to time.ParseDuration units
+// it accepts whole days ("7d") and weeks ("2w").
+func parseWindow(window string) (time.Duration, error) {
+	unit := time.Duration(0)
+	switch {
+	case strings.HasSuffix(window, "d"):
+		unit = 24 * time.Hour
+	case strings.HasSuffix(window, "w"):
+		unit = 7 * 24 * time.Hour
+	}
+	if unit == 0 {
+		d, err := time.ParseDuration(window)
+		if err != nil {
+			return 0, fmt.Errorf("invalid window %q: %w", window, err)
+		}
+		return d, nil
+	}
+	n, err := strconv.Atoi(window[:len(window)-1])
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid window %q", window)
+	}
+	return time.Duration(n) * unit, nil
+}
+
 // Repo returns details for a repository.
 func (s *Service) Repo(owner, name string) domain.Repo {
 	r, err := s.gh.Repo(context.Background(), owner, name)
@@ -68,6 +144,80 @@ func (s *Service) Repo(owner, name string) domain.Repo {
 	return r
 }
 
+// Releases returns the latest published releases of a repository with notes and assets.
+func (s *Service) Releases(owner, name string, count int) []domain.Release {
+	releases, err := s.gh.Releases(context.Background(), owner, name, count)
+	if err != nil {
+		panic(err)
+	}
+	return releases
+}
+
+// Contributors returns the most active committers of a repository, sorted by
+// commit count desc and limited to count.
+func (s *Service) Contributors(owner, name string, count int) []domain.Contributor {
+	contributors, err := s.gh.Contributors(context.Background(), owner, name)
+	if err != nil {
+		panic(err)
+	}
+	sort.SliceStable(contributors, func(i, j int) bool { return contributors[i].Commits > contributors[j].Commits })
+	if len(contributors) > count {
+		contributors = contributors[:count]
+	}
+	return contributors
+}
+
+// OpenIssues returns the newest open issues of a repository, optionally
+// restricted to issues with any of the given labels (e.g. "good first issue").
+func (s *Service) OpenIssues(owner, name string, count int, labels ...string) []domain.Issue {
+	issues, err := s.gh.OpenIssues(context.Background(), owner, name, count, labels)
+	if err != nil {
+		panic(err)
+	}
+	return issues
+}
+
+// LatestRelease returns the latest release of a repository.
+func (s *Service) LatestRelease(owner, name string) domain.Release {
+	r, err := s.gh.LatestRelease(context.Background(), owner, name)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Milestones returns the open milestones of a repository ordered by due date.
+func (s *Service) Milestones(owner, name string, count int) []domain.Milestone {
+	milestones, err := s.gh.Milestones(context.Background(), owner, name, count)
+	if err != nil {
+		panic(err)
+	}
+	return milestones
+}
+
+// Languages returns the languages of a repository ordered by size desc.
+func (s *Service) Languages(owner, name string) []domain.Language {
+	languages, err := s.gh.Languages(context.Background(), owner, name)
+	if err != nil {
+		panic(err)
+	}
+	return languages
+}
+
+// Profile returns the profile of the configured user.
+func (s *Service) Profile() domain.Profile {
+	return s.ProfileOf(s.username)
+}
+
+// ProfileOf returns the profile of the given user.
+func (s *Service) ProfileOf(login string) domain.Profile {
+	p, err := s.gh.Profile(context.Background(), login)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
 // Followers returns a list of followers for the configured user.
 func (s *Service) Followers(count int) []domain.User {
 	users, err := s.gh.Followers(context.Background(), s.username, count)
@@ -77,37 +227,123 @@ func (s *Service) Followers(count int) []domain.User {
 	return users
 }
 
+// Following returns a list of users the configured user follows.
+func (s *Service) Following(count int) []domain.User {
+	users, err := s.gh.Following(context.Background(), s.username, count)
+	if err != nil {
+		panic(err)
+	}
+	return users
+}
+
+// mutualsScanLimit caps how many followers and followed users are compared by Mutuals.
+const mutualsScanLimit = 1000
+
+// Mutuals returns users who both follow and are followed by the configured user,
+// in the order of the following list, limited to count.
+func (s *Service) Mutuals(count int) []domain.User {
+	following, err := s.gh.Following(context.Background(), s.username, mutualsScanLimit)
+	if err != nil {
+		panic(err)
+	}
+	followers, err := s.gh.Followers(context.Background(), s.username, mutualsScanLimit)
+	if err != nil {
+		panic(err)
+	}
+	isFollower := make(map[string]bool, len(followers))
+	for _, u := range followers {
+		isFollower[u.Login] = true
+	}
+	var out []domain.User
+	for _, u := range following {
+		if !isFollower[u.Login] {
+			continue
+		}
+		out = append(out, u)
+		if len(out) == count {
+			break
+		}
+	}
+	return out
+}
+
 // RecentPullRequests returns recent pull requests created by the user,
-// excluding the meta repo "username/username" and private repositories.
-func (s *Service) RecentPullRequests(count int) []domain.PullRequest {
-	prs, err := s.gh.RecentPullRequests(context.Background(), s.username, count+1)
+// excluding the meta repo "username/username".
+// Optional states ("open", "closed", "merged") restrict the result.
+func (s *Service) RecentPullRequests(count int, states ...string) []domain.PullRequest {
+	st, err := normalizeStates(states, "OPEN", "CLOSED", "MERGED")
+	if err != nil {
+		panic(err)
+	}
+	prs, err := s.gh.RecentPullRequests(context.Background(), s.username, count, st, s.filter.Allows)
 	if err != nil {
 		panic(err)
 	}
 	var out []domain.PullRequest
-	meta := fmt.Sprintf("%s/%s", s.username, s.username)
 	for _, pr := range prs {
-		if pr.Repo.Name == meta {
+		out = append(out, s.filter.RedactPullRequest(pr))
+	}
+	return out
+}
+
+// externalScanLimit caps how many merged pull requests ExternalContributions groups.
+const externalScanLimit = 300
+
+// ExternalContributions returns repositories owned by neither the user nor one of
+// their organizations that received merged pull requests from the user, grouped by
+// repository with the number of merged pull requests and the latest merge date.
+// Results are sorted by LastMergedAt desc and limited to count.
+func (s *Service) ExternalContributions(count int) []domain.ExternalContribution {
+	prs, err := s.gh.RecentPullRequests(context.Background(), s.username, externalScanLimit, []string{"MERGED"}, s.filter.Allows)
+	if err != nil {
+		panic(err)
+	}
+	orgs, err := s.gh.Organizations(context.Background(), s.username, 100)
+	if err != nil {
+		panic(err)
+	}
+	own := map[string]bool{strings.ToLower(s.username): true}
+	for _, o := range orgs {
+		own[strings.ToLower(o.Login)] = true
+	}
+
+	byRepo := map[string]*domain.ExternalContribution{}
+	var out []*domain.ExternalContribution
+	for _, pr := range prs {
+		owner, _, _ := strings.Cut(pr.Repo.Name, "/")
+		if own[strings.ToLower(owner)] {
 			continue
 		}
-		if pr.Repo.IsPrivate {
-			continue
+		c, ok := byRepo[pr.Repo.Name]
+		if !ok {
+			c = &domain.ExternalContribution{Repo: s.filter.Redact(pr.Repo)}
+			byRepo[pr.Repo.Name] = c
+			out = append(out, c)
 		}
-		out = append(out, pr)
-		if len(out) == count {
-			break
+		c.PullRequests++
+		if pr.MergedAt.After(c.LastMergedAt) {
+			c.LastMergedAt = pr.MergedAt
 		}
 	}
-	return out
+	sort.SliceStable(out, func(i, j int) bool { return out[i].LastMergedAt.After(out[j].LastMergedAt) })
+	if len(out) > count {
+		out = out[:count]
+	}
+	result := make([]domain.ExternalContribution, 0, len(out))
+	for _, c := range out {
+		result = append(result, *c)
+	}
+	return result
 }
 
 // RecentReleases returns repositories with the most recent valid releases,
 // sorted by PublishedAt desc, then Stargazers desc, limited to count.
 func (s *Service) RecentReleases(count int) []domain.Repo {
-	repos, err := s.gh.RecentReleases(context.Background(), s.username, count)
+	all, err := s.gh.RecentReleases(context.Background(), s.username, count, s.filter.Allows)
 	if err != nil {
 		panic(err)
 	}
+	repos := s.filter.RedactRepos(all)
 	// sort as in legacy implementation
 	sort.Slice(repos, func(i, j int) bool {
 		if repos[i].LastRelease.PublishedAt.Equal(repos[j].LastRelease.PublishedAt) {
@@ -122,22 +358,15 @@ func (s *Service) RecentReleases(count int) []domain.Repo {
 }
 
 // RecentContributions returns recent commit contributions by repository for the user,
-// excluding the meta repo and private repositories, sorted by time desc and limited to count.
+// excluding the meta repo, sorted by time desc and limited to count.
 func (s *Service) RecentContributions(count int) []domain.Contribution {
-	cons, err := s.gh.RecentContributions(context.Background(), s.username, count+10) // fetch a few extra for filtering
+	cons, err := s.gh.RecentContributions(context.Background(), s.username, count, s.filter.Allows)
 	if err != nil {
 		panic(err)
 	}
-	meta := fmt.Sprintf("%s/%s", s.username, s.username)
 	var out []domain.Contribution
 	for _, c := range cons {
-		if c.Repo.Name == meta {
-			continue
-		}
-		if c.Repo.IsPrivate {
-			continue
-		}
-		out = append(out, c)
+		out = append(out, domain.Contribution{OccurredAt: c.OccurredAt, Repo: s.filter.Redact(c.Repo)})
 	}
 	sort.Slice(out, func(i, j int) bool { return out[i].OccurredAt.After(out[j].OccurredAt) })
 	if len(out) > count {
@@ -146,6 +375,42 @@ func (s *Service) RecentContributions(count int) []domain.Contribution {
 	return out
 }
 
+// RecentCommits returns the user's most recent commits across their recently pushed
+// repositories, excluding the meta repo, sorted by commit
+// date desc and limited to count. Commits whose message headline matches any of the
+// optional exclude regular expressions (e.g. "^chore\\(deps\\)") are skipped.
+func (s *Service) RecentCommits(count int, exclude ...string) []domain.Commit {
+	var patterns []*regexp.Regexp
+	for _, e := range exclude {
+		re, err := regexp.Compile(e)
+		if err != nil {
+			panic(err)
+		}
+		patterns = append(patterns, re)
+	}
+	keep := func(c domain.Commit) bool {
+		if !s.filter.Allows(c.Repo) {
+			return false
+		}
+		for _, re := range patterns {
+			if re.MatchString(c.Message) {
+				return false
+			}
+		}
+		return true
+	}
+	commits, err := s.gh.RecentCommits(context.Background(), s.username, count, keep)
+	if err != nil {
+		panic(err)
+	}
+	var out []domain.Commit
+	for _, c := range commits {
+		out = append(out, s.redactCommit(c))
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CommittedAt.After(out[j].CommittedAt) })
+	return out
+}
+
 // Gists returns user's gists ordered by creation date desc limited by count.
 func (s *Service) Gists(count int) []domain.Gist {
 	gists, err := s.gh.Gists(context.Background(), s.username, count)
@@ -155,32 +420,34 @@ func (s *Service) Gists(count int) []domain.Gist {
 	return gists
 }
 
-// RecentStars returns recently starred public repositories.
+// RecentStars returns recently starred repositories.
 func (s *Service) RecentStars(count int) []domain.Star {
-	stars, err := s.gh.RecentStars(context.Background(), s.username, count)
+	stars, err := s.gh.RecentStars(context.Background(), s.username, count, s.filter.Allows)
 	if err != nil {
 		panic(err)
 	}
-	return stars
+	var out []domain.Star
+	for _, st := range stars {
+		out = append(out, domain.Star{StarredAt: st.StarredAt, Repo: s.filter.Redact(st.Repo)})
+	}
+	return out
 }
 
-// RecentIssues returns recent issue contributions grouped by repository,
-// excluding the meta repo and private repositories, sorted by time desc and limited to count.
-func (s *Service) RecentIssues(count int) []domain.Issue {
-	issues, err := s.gh.RecentIssues(context.Background(), s.username, count+10)
+// RecentIssues returns recent issues opened by the user,
+// excluding the meta repo, sorted by time desc and limited to count.
+// Optional states ("open", "closed") restrict the result.
+func (s *Service) RecentIssues(count int, states ...string) []domain.Issue {
+	st, err := normalizeStates(states, "OPEN", "CLOSED")
+	if err != nil {
+		panic(err)
+	}
+	issues, err := s.gh.RecentIssues(context.Background(), s.username, count, st, s.filter.Allows)
 	if err != nil {
 		panic(err)
 	}
-	meta := fmt.Sprintf("%s/%s", s.username, s.username)
 	var out []domain.Issue
 	for _, is := range issues {
-		if is.Repo.Name == meta {
-			continue
-		}
-		if is.Repo.IsPrivate {
-			continue
-		}
-		out = append(out, is)
+		out = append(out, s.filter.RedactIssue(is))
 	}
 	sort.Slice(out, func(i, j int) bool { return out[i].OccurredAt.After(out[j].OccurredAt) })
 	if len(out) > count {
@@ -189,6 +456,76 @@ func (s *Service) RecentIssues(count int) []domain.Issue {
 	return out
 }
 
+// RecentReviews returns recent pull request reviews submitted by the user,
+// excluding the meta repo, sorted by time desc and limited to count.
+func (s *Service) RecentReviews(count int) []domain.Review {
+	reviews, err := s.gh.RecentReviews(context.Background(), s.username, count, s.filter.Allows)
+	if err != nil {
+		panic(err)
+	}
+	var out []domain.Review
+	for _, r := range reviews {
+		out = append(out, s.redactReview(r))
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].OccurredAt.After(out[j].OccurredAt) })
+	if len(out) > count {
+		out = out[:count]
+	}
+	return out
+}
+
+// RecentDiscussions returns recent discussions started by the user,
+// excluding the meta repo, sorted by time desc and limited to count.
+func (s *Service) RecentDiscussions(count int) []domain.Discussion {
+	discussions, err := s.gh.RecentDiscussions(context.Background(), s.username, count, s.filter.Allows)
+	if err != nil {
+		panic(err)
+	}
+	return s.sortDiscussions(discussions, count)
+}
+
+// RecentDiscussionComments returns recent discussion comments by the user,
+// excluding the meta repo, sorted by time desc and limited to count.
+func (s *Service) RecentDiscussionComments(count int) []domain.Discussion {
+	comments, err := s.gh.RecentDiscussionComments(context.Background(), s.username, count, s.filter.Allows)
+	if err != nil {
+		panic(err)
+	}
+	return s.sortDiscussions(comments, count)
+}
+
+func (s *Service) sortDiscussions(discussions []domain.Discussion, count int) []domain.Discussion {
+	var out []domain.Discussion
+	for _, d := range discussions {
+		out = append(out, s.redactDiscussion(d))
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.After(out[j].CreatedAt) })
+	if len(out) > count {
+		out = out[:count]
+	}
+	return out
+}
+
+// normalizeStates upper-cases the given states and checks them against the allowed ones.
+func normalizeStates(states []string, allowed ...string) ([]string, error) {
+	var out []string
+	for _, st := range states {
+		up := strings.ToUpper(st)
+		valid := false
+		for _, a := range allowed {
+			if up == a {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, fmt.Errorf("unknown state %q, expected one of %s", st, strings.ToLower(strings.Join(allowed, ", ")))
+		}
+		out = append(out, up)
+	}
+	return out, nil
+}
+
 // Sponsors returns the most recent sponsors up to count.
 func (s *Service) Sponsors(count int) []domain.Sponsor {
 	sponsors, err := s.gh.Sponsors(context.Background(), s.username, count)
@@ -200,3 +537,92 @@ func (s *Service) Sponsors(count int) []domain.Sponsor {
 	}
 	return sponsors
 }
+
+// SponsorsGoal returns the active goal of the user's sponsors listing.
+func (s *Service) SponsorsGoal() domain.SponsorsGoal {
+	goal, err := s.gh.SponsorsGoal(context.Background(), s.username)
+	if err != nil {
+		panic(err)
+	}
+	return goal
+}
+
+// Sponsoring returns the users and organizations the user sponsors, up to count.
+func (s *Service) Sponsoring(count int) []domain.User {
+	users, err := s.gh.Sponsoring(context.Background(), s.username, count)
+	if err != nil {
+		panic(err)
+	}
+	return users
+}
+
+// PinnedRepos returns the repositories pinned to the user's profile, in pinned order.
+func (s *Service) PinnedRepos() []domain.Repo {
+	repos, err := s.gh.PinnedRepos(context.Background(), s.username)
+	if err != nil {
+		panic(err)
+	}
+	var out []domain.Repo
+	for _, r := range repos {
+		if s.filter.Allows(r) {
+			out = append(out, s.filter.Redact(r))
+		}
+	}
+	return out
+}
+
+// PinnedGists returns the gists pinned to the user's profile, in pinned order.
+func (s *Service) PinnedGists() []domain.Gist {
+	gists, err := s.gh.PinnedGists(context.Background(), s.username)
+	if err != nil {
+		panic(err)
+	}
+	return gists
+}
+
+// Activity returns the user's recent public events as a chronological timeline,
+// excluding the meta repo, newest first and limited to count.
+func (s *Service) Activity(count int) []domain.Activity {
+	events, err := s.gh.Activity(context.Background(), s.username, count, s.filter.Allows)
+	if err != nil {
+		panic(err)
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].CreatedAt.After(events[j].CreatedAt) })
+	return events
+}
+
+// WorkflowRuns returns the most recent GitHub Actions runs of repo ("owner/name")
+// across all workflows, newest first and limited to count.
+func (s *Service) WorkflowRuns(repo string, count int) []domain.WorkflowRun {
+	owner, name := splitRepo(repo)
+	runs, err := s.gh.WorkflowRuns(context.Background(), owner, name, count)
+	if err != nil {
+		panic(err)
+	}
+	if len(runs) > count {
+		runs = runs[:count]
+	}
+	return runs
+}
+
+// WorkflowStatus returns the latest run of a workflow of repo ("owner/name"),
+// given by its file name (e.g. "ci.yml"). It returns the zero value if the
+// workflow has not run yet.
+func (s *Service) WorkflowStatus(repo, workflow string) domain.WorkflowRun {
+	owner, name := splitRepo(repo)
+	run, err := s.gh.LatestWorkflowRun(context.Background(), owner, name, workflow)
+	if err != nil {
+		panic(err)
+	}
+	return run
+}
+
+// splitRepo splits "owner/name", panicking on malformed input like the other
+// template-facing errors.
+func splitRepo(repo string) (owner, name string) {
+	owner, name, ok := strings.Cut(repo, "/")
+	if !ok || owner == "" || name == "" || strings.Contains(name, "/") {
+		panic(fmt.Errorf("invalid repository %q, expected owner/name", repo))
+	}
+	return owner, name
+}
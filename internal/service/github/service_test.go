@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"hufschlaeger.net/markscribe/internal/domain"
+	forgesvc "hufschlaeger.net/markscribe/internal/service/forge"
 )
 
 // MockGithubPort is a mock implementation of ports.GithubPort
@@ -16,11 +17,43 @@ type MockGithubPort struct {
 	mock.Mock
 }
 
-func (m *MockGithubPort) RecentRepos(ctx context.Context, username string, count int, forks bool) ([]domain.Repo, error) {
+// keepItems mimics the adapter's paging: items whose repository keep rejects
+// are skipped and at most count of the others are returned.
+func keepItems[T any](items []T, count int, keep func(domain.Repo) bool, repo func(T) domain.Repo) []T {
+	var out []T
+	for _, item := range items {
+		if keep != nil && !keep(repo(item)) {
+			continue
+		}
+		if len(out) == count {
+			break
+		}
+		out = append(out, item)
+	}
+	return out
+}
+
+func (m *MockGithubPort) RecentRepos(ctx context.Context, username string, count int, forks bool, keep func(domain.Repo) bool) ([]domain.Repo, error) {
 	args := m.Called(ctx, username, count, forks)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
+	return keepItems(args.Get(0).([]domain.Repo), count, keep, func(r domain.Repo) domain.Repo { return r }), args.Error(1)
+}
+
+func (m *MockGithubPort) TopRepos(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Repo, error) {
+	args := m.Called(ctx, username, count)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return keepItems(args.Get(0).([]domain.Repo), count, keep, func(r domain.Repo) domain.Repo { return r }), args.Error(1)
+}
+
+func (m *MockGithubPort) TrendingRepos(ctx context.Context, username string, since time.Time) ([]domain.Repo, error) {
+	args := m.Called(ctx, username, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
 	return args.Get(0).([]domain.Repo), args.Error(1)
 }
 
@@ -29,6 +62,82 @@ func (m *MockGithubPort) Repo(ctx context.Context, owner, name string) (domain.R
 	return args.Get(0).(domain.Repo), args.Error(1)
 }
 
+func (m *MockGithubPort) Releases(ctx context.Context, owner, name string, count int) ([]domain.Release, error) {
+	args := m.Called(ctx, owner, name, count)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Release), args.Error(1)
+}
+
+func (m *MockGithubPort) Contributors(ctx context.Context, owner, name string) ([]domain.Contributor, error) {
+	args := m.Called(ctx, owner, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Contributor), args.Error(1)
+}
+
+func (m *MockGithubPort) OpenIssues(ctx context.Context, owner, name string, count int, labels []string) ([]domain.Issue, error) {
+	args := m.Called(ctx, owner, name, count, labels)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Issue), args.Error(1)
+}
+
+func (m *MockGithubPort) LatestRelease(ctx context.Context, owner, name string) (domain.Release, error) {
+	args := m.Called(ctx, owner, name)
+	return args.Get(0).(domain.Release), args.Error(1)
+}
+
+func (m *MockGithubPort) Milestones(ctx context.Context, owner, name string, count int) ([]domain.Milestone, error) {
+	args := m.Called(ctx, owner, name, count)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Milestone), args.Error(1)
+}
+
+func (m *MockGithubPort) Languages(ctx context.Context, owner, name string) ([]domain.Language, error) {
+	args := m.Called(ctx, owner, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Language), args.Error(1)
+}
+
+func (m *MockGithubPort) Activity(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Activity, error) {
+	args := m.Called(ctx, username, count)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return keepItems(args.Get(0).([]domain.Activity), count, keep, func(a domain.Activity) domain.Repo { return a.Repo }), args.Error(1)
+}
+
+func (m *MockGithubPort) Traffic(ctx context.Context, owner, name string) (domain.Traffic, error) {
+	args := m.Called(ctx, owner, name)
+	return args.Get(0).(domain.Traffic), args.Error(1)
+}
+
+func (m *MockGithubPort) WorkflowRuns(ctx context.Context, owner, name string, count int) ([]domain.WorkflowRun, error) {
+	args := m.Called(ctx, owner, name, count)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.WorkflowRun), args.Error(1)
+}
+
+func (m *MockGithubPort) LatestWorkflowRun(ctx context.Context, owner, name, workflow string) (domain.WorkflowRun, error) {
+	args := m.Called(ctx, owner, name, workflow)
+	return args.Get(0).(domain.WorkflowRun), args.Error(1)
+}
+
+func (m *MockGithubPort) Profile(ctx context.Context, login string) (domain.Profile, error) {
+	args := m.Called(ctx, login)
+	return args.Get(0).(domain.Profile), args.Error(1)
+}
+
 func (m *MockGithubPort) Followers(ctx context.Context, username string, count int) ([]domain.User, error) {
 	args := m.Called(ctx, username, count)
 	if args.Get(0) == nil {
@@ -37,28 +146,58 @@ func (m *MockGithubPort) Followers(ctx context.Context, username string, count i
 	return args.Get(0).([]domain.User), args.Error(1)
 }
 
-func (m *MockGithubPort) RecentPullRequests(ctx context.Context, username string, count int) ([]domain.PullRequest, error) {
+func (m *MockGithubPort) Following(ctx context.Context, username string, count int) ([]domain.User, error) {
+	args := m.Called(ctx, username, count)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.User), args.Error(1)
+}
+
+func (m *MockGithubPort) Organizations(ctx context.Context, username string, count int) ([]domain.User, error) {
 	args := m.Called(ctx, username, count)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).([]domain.PullRequest), args.Error(1)
+	return args.Get(0).([]domain.User), args.Error(1)
+}
+
+func (m *MockGithubPort) RecentPullRequests(ctx context.Context, username string, count int, states []string, keep func(domain.Repo) bool) ([]domain.PullRequest, error) {
+	args := m.Called(ctx, username, count, states)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return keepItems(args.Get(0).([]domain.PullRequest), count, keep, func(pr domain.PullRequest) domain.Repo { return pr.Repo }), args.Error(1)
 }
 
-func (m *MockGithubPort) RecentReleases(ctx context.Context, username string, count int) ([]domain.Repo, error) {
+func (m *MockGithubPort) RecentReleases(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Repo, error) {
 	args := m.Called(ctx, username, count)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).([]domain.Repo), args.Error(1)
+	return keepItems(args.Get(0).([]domain.Repo), count, keep, func(r domain.Repo) domain.Repo { return r }), args.Error(1)
+}
+
+func (m *MockGithubPort) RecentContributions(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Contribution, error) {
+	args := m.Called(ctx, username, count)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return keepItems(args.Get(0).([]domain.Contribution), count, keep, func(c domain.Contribution) domain.Repo { return c.Repo }), args.Error(1)
 }
 
-func (m *MockGithubPort) RecentContributions(ctx context.Context, username string, count int) ([]domain.Contribution, error) {
+func (m *MockGithubPort) RecentCommits(ctx context.Context, username string, count int, keep func(domain.Commit) bool) ([]domain.Commit, error) {
 	args := m.Called(ctx, username, count)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).([]domain.Contribution), args.Error(1)
+	var out []domain.Commit
+	for _, c := range args.Get(0).([]domain.Commit) {
+		if len(out) < count && keep(c) {
+			out = append(out, c)
+		}
+	}
+	return out, args.Error(1)
 }
 
 func (m *MockGithubPort) Gists(ctx context.Context, username string, count int) ([]domain.Gist, error) {
@@ -69,20 +208,44 @@ func (m *MockGithubPort) Gists(ctx context.Context, username string, count int)
 	return args.Get(0).([]domain.Gist), args.Error(1)
 }
 
-func (m *MockGithubPort) RecentStars(ctx context.Context, username string, count int) ([]domain.Star, error) {
+func (m *MockGithubPort) RecentStars(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Star, error) {
+	args := m.Called(ctx, username, count)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return keepItems(args.Get(0).([]domain.Star), count, keep, func(st domain.Star) domain.Repo { return st.Repo }), args.Error(1)
+}
+
+func (m *MockGithubPort) RecentIssues(ctx context.Context, username string, count int, states []string, keep func(domain.Repo) bool) ([]domain.Issue, error) {
+	args := m.Called(ctx, username, count, states)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return keepItems(args.Get(0).([]domain.Issue), count, keep, func(is domain.Issue) domain.Repo { return is.Repo }), args.Error(1)
+}
+
+func (m *MockGithubPort) RecentReviews(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Review, error) {
 	args := m.Called(ctx, username, count)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).([]domain.Star), args.Error(1)
+	return keepItems(args.Get(0).([]domain.Review), count, keep, func(r domain.Review) domain.Repo { return r.PullRequest.Repo }), args.Error(1)
 }
 
-func (m *MockGithubPort) RecentIssues(ctx context.Context, username string, count int) ([]domain.Issue, error) {
+func (m *MockGithubPort) RecentDiscussions(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Discussion, error) {
 	args := m.Called(ctx, username, count)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).([]domain.Issue), args.Error(1)
+	return keepItems(args.Get(0).([]domain.Discussion), count, keep, func(d domain.Discussion) domain.Repo { return d.Repo }), args.Error(1)
+}
+
+func (m *MockGithubPort) RecentDiscussionComments(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Discussion, error) {
+	args := m.Called(ctx, username, count)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return keepItems(args.Get(0).([]domain.Discussion), count, keep, func(d domain.Discussion) domain.Repo { return d.Repo }), args.Error(1)
 }
 
 func (m *MockGithubPort) Sponsors(ctx context.Context, username string, count int) ([]domain.Sponsor, error) {
@@ -93,6 +256,35 @@ func (m *MockGithubPort) Sponsors(ctx context.Context, username string, count in
 	return args.Get(0).([]domain.Sponsor), args.Error(1)
 }
 
+func (m *MockGithubPort) SponsorsGoal(ctx context.Context, username string) (domain.SponsorsGoal, error) {
+	args := m.Called(ctx, username)
+	return args.Get(0).(domain.SponsorsGoal), args.Error(1)
+}
+
+func (m *MockGithubPort) Sponsoring(ctx context.Context, username string, count int) ([]domain.User, error) {
+	args := m.Called(ctx, username, count)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.User), args.Error(1)
+}
+
+func (m *MockGithubPort) PinnedRepos(ctx context.Context, username string) ([]domain.Repo, error) {
+	args := m.Called(ctx, username)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Repo), args.Error(1)
+}
+
+func (m *MockGithubPort) PinnedGists(ctx context.Context, username string) ([]domain.Gist, error) {
+	args := m.Called(ctx, username)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Gist), args.Error(1)
+}
+
 func (m *MockGithubPort) ViewerLogin(ctx context.Context) (string, error) {
 	return "", nil
 }
@@ -159,7 +351,7 @@ func TestService_RecentRepos(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockGH := new(MockGithubPort)
-			mockGH.On("RecentRepos", mock.Anything, tt.username, tt.count+1, false).
+			mockGH.On("RecentRepos", mock.Anything, tt.username, tt.count, false).
 				Return(tt.mockRepos, tt.mockError)
 
 			svc := New(mockGH, tt.username)
@@ -218,7 +410,7 @@ func TestService_RecentForks(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockGH := new(MockGithubPort)
-			mockGH.On("RecentRepos", mock.Anything, tt.username, tt.count+1, true).
+			mockGH.On("RecentRepos", mock.Anything, tt.username, tt.count, true).
 				Return(tt.mockRepos, tt.mockError)
 
 			svc := New(mockGH, tt.username)
@@ -239,6 +431,178 @@ func TestService_RecentForks(t *testing.T) {
 	}
 }
 
+func TestService_TopRepos(t *testing.T) {
+	tests := []struct {
+		name           string
+		username       string
+		count          int
+		mockRepos      []domain.Repo
+		mockError      error
+		expectedPanic  bool
+		expectedResult []domain.Repo
+	}{
+		{
+			name:     "filters meta repo and keeps star order",
+			username: "testuser",
+			count:    2,
+			mockRepos: []domain.Repo{
+				{Name: "testuser/popular", Stargazers: 100},
+				{Name: "testuser/testuser", Stargazers: 50}, // meta repo - should be filtered
+				{Name: "testuser/other", Stargazers: 10},
+			},
+			expectedResult: []domain.Repo{
+				{Name: "testuser/popular", Stargazers: 100},
+				{Name: "testuser/other", Stargazers: 10},
+			},
+		},
+		{
+			name:          "panics on error",
+			username:      "testuser",
+			count:         2,
+			mockError:     errors.New("api error"),
+			expectedPanic: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGH := new(MockGithubPort)
+			mockGH.On("TopRepos", mock.Anything, tt.username, tt.count).
+				Return(tt.mockRepos, tt.mockError)
+
+			svc := New(mockGH, tt.username)
+
+			if tt.expectedPanic {
+				assert.Panics(t, func() {
+					svc.TopRepos(tt.count)
+				})
+				return
+			}
+
+			result := svc.TopRepos(tt.count)
+
+			assert.Equal(t, tt.expectedResult, result)
+			mockGH.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_TrendingRepos(t *testing.T) {
+	tests := []struct {
+		name           string
+		username       string
+		count          int
+		window         string
+		mockRepos      []domain.Repo
+		mockError      error
+		expectedPanic  bool
+		expectedResult []domain.Repo
+	}{
+		{
+			name:     "sorts by delta desc, then stargazers, dropping repos without new stars",
+			username: "testuser",
+			count:    3,
+			window:   "7d",
+			mockRepos: []domain.Repo{
+				{Name: "testuser/a", Stargazers: 500, StargazersDelta: 2},
+				{Name: "testuser/b", Stargazers: 20, StargazersDelta: 9},
+				{Name: "testuser/c", Stargazers: 300, StargazersDelta: 0},
+				{Name: "testuser/testuser", Stargazers: 40, StargazersDelta: 30}, // meta repo - should be filtered
+				{Name: "testuser/d", Stargazers: 90, StargazersDelta: 2},
+			},
+			expectedResult: []domain.Repo{
+				{Name: "testuser/b", Stargazers: 20, StargazersDelta: 9},
+				{Name: "testuser/a", Stargazers: 500, StargazersDelta: 2},
+				{Name: "testuser/d", Stargazers: 90, StargazersDelta: 2},
+			},
+		},
+		{
+			name:     "limits to count",
+			username: "testuser",
+			count:    1,
+			window:   "24h",
+			mockRepos: []domain.Repo{
+				{Name: "testuser/a", StargazersDelta: 1},
+				{Name: "testuser/b", StargazersDelta: 3},
+			},
+			expectedResult: []domain.Repo{
+				{Name: "testuser/b", StargazersDelta: 3},
+			},
+		},
+		{
+			name:          "panics on error",
+			username:      "testuser",
+			count:         2,
+			window:        "7d",
+			mockError:     errors.New("api error"),
+			expectedPanic: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			window, err := parseWindow(tt.window)
+			assert.NoError(t, err)
+
+			mockGH := new(MockGithubPort)
+			mockGH.On("TrendingRepos", mock.Anything, tt.username, mock.MatchedBy(func(since time.Time) bool {
+				return time.Since(since)-window < time.Minute
+			})).Return(tt.mockRepos, tt.mockError)
+
+			svc := New(mockGH, tt.username)
+
+			if tt.expectedPanic {
+				assert.Panics(t, func() {
+					svc.TrendingRepos(tt.count, tt.window)
+				})
+				return
+			}
+
+			result := svc.TrendingRepos(tt.count, tt.window)
+
+			assert.Equal(t, tt.expectedResult, result)
+			mockGH.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_TrendingRepos_PanicsOnInvalidWindow(t *testing.T) {
+	svc := New(new(MockGithubPort), "testuser")
+
+	assert.Panics(t, func() {
+		svc.TrendingRepos(5, "a week")
+	})
+}
+
+func TestParseWindow(t *testing.T) {
+	tests := []struct {
+		window      string
+		expected    time.Duration
+		expectedErr bool
+	}{
+		{window: "7d", expected: 7 * 24 * time.Hour},
+		{window: "2w", expected: 14 * 24 * time.Hour},
+		{window: "36h", expected: 36 * time.Hour},
+		{window: "90m", expected: 90 * time.Minute},
+		{window: "xd", expectedErr: true},
+		{window: "-1d", expectedErr: true},
+		{window: "", expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.window, func(t *testing.T) {
+			d, err := parseWindow(tt.window)
+
+			if tt.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, d)
+		})
+	}
+}
+
 func TestService_Repo(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -286,27 +650,32 @@ func TestService_Repo(t *testing.T) {
 	}
 }
 
-func TestService_Followers(t *testing.T) {
+func TestService_Releases(t *testing.T) {
 	tests := []struct {
 		name          string
-		username      string
 		count         int
-		mockUsers     []domain.User
+		mockReleases  []domain.Release
 		mockError     error
 		expectedPanic bool
 	}{
 		{
-			name:     "successful retrieval",
-			username: "testuser",
-			count:    2,
-			mockUsers: []domain.User{
-				{Login: "follower1"},
-				{Login: "follower2"},
+			name:  "successful retrieval",
+			count: 2,
+			mockReleases: []domain.Release{
+				{
+					TagName:     "v1.1.0",
+					Description: "## Features\n- x",
+					Assets: []domain.ReleaseAsset{
+						{Name: "tool_linux.tar.gz", Size: 1024, DownloadCount: 30},
+						{Name: "tool_darwin.tar.gz", Size: 2048, DownloadCount: 12},
+					},
+					Downloads: 42,
+				},
+				{TagName: "v1.0.0"},
 			},
 		},
 		{
 			name:          "panics on error",
-			username:      "testuser",
 			count:         2,
 			mockError:     errors.New("api error"),
 			expectedPanic: true,
@@ -316,21 +685,467 @@ func TestService_Followers(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockGH := new(MockGithubPort)
-			mockGH.On("Followers", mock.Anything, tt.username, tt.count).
-				Return(tt.mockUsers, tt.mockError)
+			mockGH.On("Releases", mock.Anything, "owner", "tool", tt.count).
+				Return(tt.mockReleases, tt.mockError)
 
-			svc := New(mockGH, tt.username)
+			svc := New(mockGH, "testuser")
 
 			if tt.expectedPanic {
 				assert.Panics(t, func() {
-					svc.Followers(tt.count)
+					svc.Releases("owner", "tool", tt.count)
 				})
 				return
 			}
 
-			result := svc.Followers(tt.count)
+			result := svc.Releases("owner", "tool", tt.count)
 
-			assert.Equal(t, tt.mockUsers, result)
+			assert.Equal(t, tt.mockReleases, result)
+			mockGH.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_Contributors(t *testing.T) {
+	tests := []struct {
+		name             string
+		count            int
+		mockContributors []domain.Contributor
+		mockError        error
+		expectedPanic    bool
+		expectedResult   []domain.Contributor
+	}{
+		{
+			name:  "sorts by commits desc and limits to count",
+			count: 2,
+			mockContributors: []domain.Contributor{
+				{User: domain.User{Login: "alice"}, Commits: 3},
+				{User: domain.User{Login: "bob"}, Commits: 10},
+				{User: domain.User{Login: "carol"}, Commits: 3},
+			},
+			expectedResult: []domain.Contributor{
+				{User: domain.User{Login: "bob"}, Commits: 10},
+				{User: domain.User{Login: "alice"}, Commits: 3},
+			},
+		},
+		{
+			name:          "panics on error",
+			count:         2,
+			mockError:     errors.New("api error"),
+			expectedPanic: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGH := new(MockGithubPort)
+			mockGH.On("Contributors", mock.Anything, "owner", "repo").
+				Return(tt.mockContributors, tt.mockError)
+
+			svc := New(mockGH, "testuser")
+
+			if tt.expectedPanic {
+				assert.Panics(t, func() {
+					svc.Contributors("owner", "repo", tt.count)
+				})
+				return
+			}
+
+			result := svc.Contributors("owner", "repo", tt.count)
+
+			assert.Equal(t, tt.expectedResult, result)
+			mockGH.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_OpenIssues(t *testing.T) {
+	tests := []struct {
+		name          string
+		count         int
+		labels        []string
+		mockIssues    []domain.Issue
+		mockError     error
+		expectedPanic bool
+	}{
+		{
+			name:       "without label filter",
+			count:      2,
+			mockIssues: []domain.Issue{{Number: 2, State: "OPEN"}, {Number: 1, State: "OPEN"}},
+		},
+		{
+			name:       "with label filter",
+			count:      5,
+			labels:     []string{"good first issue"},
+			mockIssues: []domain.Issue{{Number: 4, Labels: []string{"good first issue"}}},
+		},
+		{
+			name:          "panics on error",
+			count:         2,
+			mockError:     errors.New("api error"),
+			expectedPanic: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGH := new(MockGithubPort)
+			mockGH.On("OpenIssues", mock.Anything, "owner", "repo", tt.count, tt.labels).
+				Return(tt.mockIssues, tt.mockError)
+
+			svc := New(mockGH, "testuser")
+
+			if tt.expectedPanic {
+				assert.Panics(t, func() {
+					svc.OpenIssues("owner", "repo", tt.count, tt.labels...)
+				})
+				return
+			}
+
+			result := svc.OpenIssues("owner", "repo", tt.count, tt.labels...)
+
+			assert.Equal(t, tt.mockIssues, result)
+			mockGH.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_LatestRelease(t *testing.T) {
+	mockGH := new(MockGithubPort)
+	release := domain.Release{TagName: "v2.0.0", Downloads: 99}
+	mockGH.On("LatestRelease", mock.Anything, "owner", "repo").Return(release, nil)
+
+	svc := New(mockGH, "testuser")
+
+	assert.Equal(t, release, svc.LatestRelease("owner", "repo"))
+	mockGH.AssertExpectations(t)
+
+	failing := new(MockGithubPort)
+	failing.On("LatestRelease", mock.Anything, "owner", "repo").Return(domain.Release{}, errors.New("api error"))
+
+	assert.Panics(t, func() {
+		New(failing, "testuser").LatestRelease("owner", "repo")
+	})
+}
+
+func TestService_Milestones(t *testing.T) {
+	tests := []struct {
+		name           string
+		count          int
+		mockMilestones []domain.Milestone
+		mockError      error
+		expectedPanic  bool
+	}{
+		{
+			name:  "successful retrieval",
+			count: 2,
+			mockMilestones: []domain.Milestone{
+				{Title: "v1.0", ProgressPercentage: 75, OpenIssues: 1, ClosedIssues: 3},
+				{Title: "v2.0"},
+			},
+		},
+		{
+			name:          "panics on error",
+			count:         2,
+			mockError:     errors.New("api error"),
+			expectedPanic: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGH := new(MockGithubPort)
+			mockGH.On("Milestones", mock.Anything, "owner", "repo", tt.count).
+				Return(tt.mockMilestones, tt.mockError)
+
+			svc := New(mockGH, "testuser")
+
+			if tt.expectedPanic {
+				assert.Panics(t, func() {
+					svc.Milestones("owner", "repo", tt.count)
+				})
+				return
+			}
+
+			result := svc.Milestones("owner", "repo", tt.count)
+
+			assert.Equal(t, tt.mockMilestones, result)
+			mockGH.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_Languages(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockLanguages []domain.Language
+		mockError     error
+		expectedPanic bool
+	}{
+		{
+			name: "successful retrieval",
+			mockLanguages: []domain.Language{
+				{Name: "Go", Color: "#00ADD8", Size: 900, Percent: 90},
+				{Name: "Makefile", Color: "#427819", Size: 100, Percent: 10},
+			},
+		},
+		{
+			name:          "panics on error",
+			mockError:     errors.New("api error"),
+			expectedPanic: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGH := new(MockGithubPort)
+			mockGH.On("Languages", mock.Anything, "owner", "repo").
+				Return(tt.mockLanguages, tt.mockError)
+
+			svc := New(mockGH, "testuser")
+
+			if tt.expectedPanic {
+				assert.Panics(t, func() {
+					svc.Languages("owner", "repo")
+				})
+				return
+			}
+
+			result := svc.Languages("owner", "repo")
+
+			assert.Equal(t, tt.mockLanguages, result)
+			mockGH.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_Profile(t *testing.T) {
+	tests := []struct {
+		name          string
+		login         string
+		useOf         bool
+		mockProfile   domain.Profile
+		mockError     error
+		expectedPanic bool
+	}{
+		{
+			name:  "profile of configured user",
+			login: "testuser",
+			mockProfile: domain.Profile{
+				Login:       "testuser",
+				Name:        "Test User",
+				StatusEmoji: ":coffee:",
+				Followers:   42,
+				PublicRepos: 7,
+			},
+		},
+		{
+			name:        "profile of other user",
+			login:       "someoneelse",
+			useOf:       true,
+			mockProfile: domain.Profile{Login: "someoneelse", Bio: "hi"},
+		},
+		{
+			name:          "panics on error",
+			login:         "testuser",
+			mockError:     errors.New("api error"),
+			expectedPanic: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGH := new(MockGithubPort)
+			mockGH.On("Profile", mock.Anything, tt.login).
+				Return(tt.mockProfile, tt.mockError)
+
+			svc := New(mockGH, "testuser")
+			call := svc.Profile
+			if tt.useOf {
+				call = func() domain.Profile { return svc.ProfileOf(tt.login) }
+			}
+
+			if tt.expectedPanic {
+				assert.Panics(t, func() {
+					call()
+				})
+				return
+			}
+
+			result := call()
+
+			assert.Equal(t, tt.mockProfile, result)
+			mockGH.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_Followers(t *testing.T) {
+	tests := []struct {
+		name          string
+		username      string
+		count         int
+		mockUsers     []domain.User
+		mockError     error
+		expectedPanic bool
+	}{
+		{
+			name:     "successful retrieval",
+			username: "testuser",
+			count:    2,
+			mockUsers: []domain.User{
+				{Login: "follower1"},
+				{Login: "follower2"},
+			},
+		},
+		{
+			name:          "panics on error",
+			username:      "testuser",
+			count:         2,
+			mockError:     errors.New("api error"),
+			expectedPanic: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGH := new(MockGithubPort)
+			mockGH.On("Followers", mock.Anything, tt.username, tt.count).
+				Return(tt.mockUsers, tt.mockError)
+
+			svc := New(mockGH, tt.username)
+
+			if tt.expectedPanic {
+				assert.Panics(t, func() {
+					svc.Followers(tt.count)
+				})
+				return
+			}
+
+			result := svc.Followers(tt.count)
+
+			assert.Equal(t, tt.mockUsers, result)
+			mockGH.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_Following(t *testing.T) {
+	tests := []struct {
+		name          string
+		username      string
+		count         int
+		mockUsers     []domain.User
+		mockError     error
+		expectedPanic bool
+	}{
+		{
+			name:     "successful retrieval",
+			username: "testuser",
+			count:    2,
+			mockUsers: []domain.User{
+				{Login: "followed1"},
+				{Login: "followed2"},
+			},
+		},
+		{
+			name:          "panics on error",
+			username:      "testuser",
+			count:         2,
+			mockError:     errors.New("api error"),
+			expectedPanic: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGH := new(MockGithubPort)
+			mockGH.On("Following", mock.Anything, tt.username, tt.count).
+				Return(tt.mockUsers, tt.mockError)
+
+			svc := New(mockGH, tt.username)
+
+			if tt.expectedPanic {
+				assert.Panics(t, func() {
+					svc.Following(tt.count)
+				})
+				return
+			}
+
+			result := svc.Following(tt.count)
+
+			assert.Equal(t, tt.mockUsers, result)
+			mockGH.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_Mutuals(t *testing.T) {
+	tests := []struct {
+		name           string
+		count          int
+		mockFollowing  []domain.User
+		mockFollowers  []domain.User
+		followingError error
+		followersError error
+		expectedPanic  bool
+		expectedResult []domain.User
+	}{
+		{
+			name:  "intersection in following order",
+			count: 5,
+			mockFollowing: []domain.User{
+				{Login: "carol"}, {Login: "alice"}, {Login: "dave"}, {Login: "bob"},
+			},
+			mockFollowers: []domain.User{
+				{Login: "bob"}, {Login: "alice"}, {Login: "erin"},
+			},
+			expectedResult: []domain.User{{Login: "alice"}, {Login: "bob"}},
+		},
+		{
+			name:  "limits to count",
+			count: 1,
+			mockFollowing: []domain.User{
+				{Login: "alice"}, {Login: "bob"},
+			},
+			mockFollowers: []domain.User{
+				{Login: "bob"}, {Login: "alice"},
+			},
+			expectedResult: []domain.User{{Login: "alice"}},
+		},
+		{
+			name:           "panics on following error",
+			count:          2,
+			followingError: errors.New("api error"),
+			expectedPanic:  true,
+		},
+		{
+			name:           "panics on followers error",
+			count:          2,
+			followersError: errors.New("api error"),
+			expectedPanic:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGH := new(MockGithubPort)
+			mockGH.On("Following", mock.Anything, "testuser", mutualsScanLimit).
+				Return(tt.mockFollowing, tt.followingError)
+			mockGH.On("Followers", mock.Anything, "testuser", mutualsScanLimit).
+				Return(tt.mockFollowers, tt.followersError).Maybe()
+
+			svc := New(mockGH, "testuser")
+
+			if tt.expectedPanic {
+				assert.Panics(t, func() {
+					svc.Mutuals(tt.count)
+				})
+				return
+			}
+
+			result := svc.Mutuals(tt.count)
+
+			assert.Equal(t, tt.expectedResult, result)
 			mockGH.AssertExpectations(t)
 		})
 	}
@@ -341,26 +1156,768 @@ func TestService_RecentPullRequests(t *testing.T) {
 		name           string
 		username       string
 		count          int
-		mockPRs        []domain.PullRequest
+		mockPRs        []domain.PullRequest
+		mockError      error
+		expectedLen    int
+		expectedPanic  bool
+		expectedResult []domain.PullRequest
+	}{
+		{
+			name:     "filters meta repo and private repos",
+			username: "testuser",
+			count:    2,
+			mockPRs: []domain.PullRequest{
+				{Repo: domain.Repo{Name: "testuser/repo1", IsPrivate: false}},
+				{Repo: domain.Repo{Name: "testuser/testuser", IsPrivate: false}}, // meta - filtered
+				{Repo: domain.Repo{Name: "testuser/repo2", IsPrivate: true}},     // private - filtered
+				{Repo: domain.Repo{Name: "testuser/repo3", IsPrivate: false}},
+			},
+			expectedLen: 2,
+			expectedResult: []domain.PullRequest{
+				{Repo: domain.Repo{Name: "testuser/repo1", IsPrivate: false}},
+				{Repo: domain.Repo{Name: "testuser/repo3", IsPrivate: false}},
+			},
+		},
+		{
+			name:          "panics on error",
+			username:      "testuser",
+			count:         2,
+			mockError:     errors.New("api error"),
+			expectedPanic: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGH := new(MockGithubPort)
+			mockGH.On("RecentPullRequests", mock.Anything, tt.username, tt.count, []string(nil)).
+				Return(tt.mockPRs, tt.mockError)
+
+			svc := New(mockGH, tt.username)
+
+			if tt.expectedPanic {
+				assert.Panics(t, func() {
+					svc.RecentPullRequests(tt.count)
+				})
+				return
+			}
+
+			result := svc.RecentPullRequests(tt.count)
+
+			assert.Len(t, result, tt.expectedLen)
+			assert.Equal(t, tt.expectedResult, result)
+			mockGH.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_RecentPullRequests_States(t *testing.T) {
+	mockGH := new(MockGithubPort)
+	merged := []domain.PullRequest{
+		{Number: 7, State: "MERGED", Repo: domain.Repo{Name: "other/repo"}},
+	}
+	mockGH.On("RecentPullRequests", mock.Anything, "testuser", 5, []string{"MERGED"}).
+		Return(merged, nil)
+
+	svc := New(mockGH, "testuser")
+	result := svc.RecentPullRequests(5, "merged")
+
+	assert.Equal(t, merged, result)
+	mockGH.AssertExpectations(t)
+}
+
+func TestService_RecentPullRequests_PanicsOnUnknownState(t *testing.T) {
+	svc := New(new(MockGithubPort), "testuser")
+
+	assert.Panics(t, func() {
+		svc.RecentPullRequests(5, "draft")
+	})
+}
+
+func TestService_ExternalContributions(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-24 * time.Hour)
+	earliest := now.Add(-48 * time.Hour)
+
+	tests := []struct {
+		name           string
+		count          int
+		mockPRs        []domain.PullRequest
+		mockOrgs       []domain.User
+		prError        error
+		orgError       error
+		expectedPanic  bool
+		expectedResult []domain.ExternalContribution
+	}{
+		{
+			name:  "groups by repo, skipping own, org, meta and private repos",
+			count: 5,
+			mockPRs: []domain.PullRequest{
+				{Repo: domain.Repo{Name: "golang/go"}, MergedAt: earliest},
+				{Repo: domain.Repo{Name: "TestUser/tool"}, MergedAt: now},                // own - filtered
+				{Repo: domain.Repo{Name: "myorg/service"}, MergedAt: now},                // org - filtered
+				{Repo: domain.Repo{Name: "testuser/testuser"}, MergedAt: now},            // meta - filtered
+				{Repo: domain.Repo{Name: "corp/secret", IsPrivate: true}, MergedAt: now}, // private - filtered
+				{Repo: domain.Repo{Name: "kubernetes/kubernetes"}, MergedAt: earlier},
+				{Repo: domain.Repo{Name: "golang/go"}, MergedAt: now},
+			},
+			mockOrgs: []domain.User{{Login: "MyOrg"}},
+			expectedResult: []domain.ExternalContribution{
+				{Repo: domain.Repo{Name: "golang/go"}, PullRequests: 2, LastMergedAt: now},
+				{Repo: domain.Repo{Name: "kubernetes/kubernetes"}, PullRequests: 1, LastMergedAt: earlier},
+			},
+		},
+		{
+			name:  "limits to count",
+			count: 1,
+			mockPRs: []domain.PullRequest{
+				{Repo: domain.Repo{Name: "a/a"}, MergedAt: earlier},
+				{Repo: domain.Repo{Name: "b/b"}, MergedAt: now},
+			},
+			expectedResult: []domain.ExternalContribution{
+				{Repo: domain.Repo{Name: "b/b"}, PullRequests: 1, LastMergedAt: now},
+			},
+		},
+		{
+			name:          "panics on pull request error",
+			count:         2,
+			prError:       errors.New("api error"),
+			expectedPanic: true,
+		},
+		{
+			name:          "panics on organization error",
+			count:         2,
+			orgError:      errors.New("api error"),
+			expectedPanic: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGH := new(MockGithubPort)
+			mockGH.On("RecentPullRequests", mock.Anything, "testuser", externalScanLimit, []string{"MERGED"}).
+				Return(tt.mockPRs, tt.prError)
+			mockGH.On("Organizations", mock.Anything, "testuser", 100).
+				Return(tt.mockOrgs, tt.orgError).Maybe()
+
+			svc := New(mockGH, "testuser")
+
+			if tt.expectedPanic {
+				assert.Panics(t, func() {
+					svc.ExternalContributions(tt.count)
+				})
+				return
+			}
+
+			result := svc.ExternalContributions(tt.count)
+
+			assert.Equal(t, tt.expectedResult, result)
+			mockGH.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_RecentReleases(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-1 * time.Hour)
+
+	tests := []struct {
+		name           string
+		username       string
+		count          int
+		mockRepos      []domain.Repo
+		mockError      error
+		expectedLen    int
+		expectedPanic  bool
+		expectedResult []domain.Repo
+	}{
+		{
+			name:     "sorts by PublishedAt desc, then Stargazers",
+			username: "testuser",
+			count:    3,
+			mockRepos: []domain.Repo{
+				{Name: "repo1", LastRelease: domain.Release{PublishedAt: earlier}, Stargazers: 10},
+				{Name: "repo2", LastRelease: domain.Release{PublishedAt: now}, Stargazers: 5},
+				{Name: "repo3", LastRelease: domain.Release{PublishedAt: now}, Stargazers: 15},
+			},
+			expectedLen: 3,
+			expectedResult: []domain.Repo{
+				{Name: "repo3", LastRelease: domain.Release{PublishedAt: now}, Stargazers: 15},
+				{Name: "repo2", LastRelease: domain.Release{PublishedAt: now}, Stargazers: 5},
+				{Name: "repo1", LastRelease: domain.Release{PublishedAt: earlier}, Stargazers: 10},
+			},
+		},
+		{
+			name:     "limits to count",
+			username: "testuser",
+			count:    2,
+			mockRepos: []domain.Repo{
+				{Name: "repo1", LastRelease: domain.Release{PublishedAt: now}},
+				{Name: "repo2", LastRelease: domain.Release{PublishedAt: now.Add(-1 * time.Hour)}},
+				{Name: "repo3", LastRelease: domain.Release{PublishedAt: now.Add(-2 * time.Hour)}},
+			},
+			expectedLen: 2,
+		},
+		{
+			name:          "panics on error",
+			username:      "testuser",
+			count:         2,
+			mockError:     errors.New("api error"),
+			expectedPanic: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGH := new(MockGithubPort)
+			mockGH.On("RecentReleases", mock.Anything, tt.username, tt.count).
+				Return(tt.mockRepos, tt.mockError)
+
+			svc := New(mockGH, tt.username)
+
+			if tt.expectedPanic {
+				assert.Panics(t, func() {
+					svc.RecentReleases(tt.count)
+				})
+				return
+			}
+
+			result := svc.RecentReleases(tt.count)
+
+			assert.Len(t, result, tt.expectedLen)
+			if tt.expectedResult != nil {
+				assert.Equal(t, tt.expectedResult, result)
+			}
+			mockGH.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_RecentContributions(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-1 * time.Hour)
+
+	tests := []struct {
+		name              string
+		username          string
+		count             int
+		mockContributions []domain.Contribution
+		mockError         error
+		expectedLen       int
+		expectedPanic     bool
+		expectedResult    []domain.Contribution
+	}{
+		{
+			name:     "filters meta repo and private repos, sorts by time",
+			username: "testuser",
+			count:    2,
+			mockContributions: []domain.Contribution{
+				{Repo: domain.Repo{Name: "testuser/repo1", IsPrivate: false}, OccurredAt: earlier},
+				{Repo: domain.Repo{Name: "testuser/testuser", IsPrivate: false}, OccurredAt: now}, // meta - filtered
+				{Repo: domain.Repo{Name: "testuser/repo2", IsPrivate: true}, OccurredAt: now},     // private - filtered
+				{Repo: domain.Repo{Name: "testuser/repo3", IsPrivate: false}, OccurredAt: now},
+			},
+			expectedLen: 2,
+			expectedResult: []domain.Contribution{
+				{Repo: domain.Repo{Name: "testuser/repo3", IsPrivate: false}, OccurredAt: now},
+				{Repo: domain.Repo{Name: "testuser/repo1", IsPrivate: false}, OccurredAt: earlier},
+			},
+		},
+		{
+			name:          "panics on error",
+			username:      "testuser",
+			count:         2,
+			mockError:     errors.New("api error"),
+			expectedPanic: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGH := new(MockGithubPort)
+			mockGH.On("RecentContributions", mock.Anything, tt.username, tt.count).
+				Return(tt.mockContributions, tt.mockError)
+
+			svc := New(mockGH, tt.username)
+
+			if tt.expectedPanic {
+				assert.Panics(t, func() {
+					svc.RecentContributions(tt.count)
+				})
+				return
+			}
+
+			result := svc.RecentContributions(tt.count)
+
+			assert.Len(t, result, tt.expectedLen)
+			if tt.expectedResult != nil {
+				assert.Equal(t, tt.expectedResult, result)
+			}
+			mockGH.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_RecentCommits(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-1 * time.Hour)
+	earliest := now.Add(-2 * time.Hour)
+
+	tests := []struct {
+		name           string
+		username       string
+		count          int
+		exclude        []string
+		mockCommits    []domain.Commit
+		mockError      error
+		expectedPanic  bool
+		expectedResult []domain.Commit
+	}{
+		{
+			name:     "merges repos by commit date, filters meta and private repos",
+			username: "testuser",
+			count:    3,
+			mockCommits: []domain.Commit{
+				{SHA: "a1", Repo: domain.Repo{Name: "testuser/a"}, CommittedAt: earliest},
+				{SHA: "a2", Repo: domain.Repo{Name: "testuser/a"}, CommittedAt: now},
+				{SHA: "m1", Repo: domain.Repo{Name: "testuser/testuser"}, CommittedAt: now},           // meta - filtered
+				{SHA: "p1", Repo: domain.Repo{Name: "testuser/p", IsPrivate: true}, CommittedAt: now}, // private - filtered
+				{SHA: "b1", Repo: domain.Repo{Name: "testuser/b"}, CommittedAt: earlier},
+			},
+			expectedResult: []domain.Commit{
+				{SHA: "a2", Repo: domain.Repo{Name: "testuser/a"}, CommittedAt: now},
+				{SHA: "b1", Repo: domain.Repo{Name: "testuser/b"}, CommittedAt: earlier},
+				{SHA: "a1", Repo: domain.Repo{Name: "testuser/a"}, CommittedAt: earliest},
+			},
+		},
+		{
+			name:     "excludes messages matching patterns",
+			username: "testuser",
+			count:    2,
+			exclude:  []string{`^chore\(deps\)`, `^Merge `},
+			mockCommits: []domain.Commit{
+				{SHA: "a1", Message: "chore(deps): bump x", Repo: domain.Repo{Name: "testuser/a"}, CommittedAt: now},
+				{SHA: "a2", Message: "Merge branch 'main'", Repo: domain.Repo{Name: "testuser/a"}, CommittedAt: now},
+				{SHA: "a3", Message: "Fix parser", Repo: domain.Repo{Name: "testuser/a"}, CommittedAt: earlier},
+			},
+			expectedResult: []domain.Commit{
+				{SHA: "a3", Message: "Fix parser", Repo: domain.Repo{Name: "testuser/a"}, CommittedAt: earlier},
+			},
+		},
+		{
+			name:          "panics on error",
+			username:      "testuser",
+			count:         2,
+			mockError:     errors.New("api error"),
+			expectedPanic: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGH := new(MockGithubPort)
+			mockGH.On("RecentCommits", mock.Anything, tt.username, tt.count).
+				Return(tt.mockCommits, tt.mockError)
+
+			svc := New(mockGH, tt.username)
+
+			if tt.expectedPanic {
+				assert.Panics(t, func() {
+					svc.RecentCommits(tt.count, tt.exclude...)
+				})
+				return
+			}
+
+			result := svc.RecentCommits(tt.count, tt.exclude...)
+
+			assert.Equal(t, tt.expectedResult, result)
+			mockGH.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_RecentCommits_PanicsOnInvalidPattern(t *testing.T) {
+	svc := New(new(MockGithubPort), "testuser")
+
+	assert.Panics(t, func() {
+		svc.RecentCommits(5, "chore(deps")
+	})
+}
+
+func TestService_Gists(t *testing.T) {
+	tests := []struct {
+		name          string
+		username      string
+		count         int
+		mockGists     []domain.Gist
+		mockError     error
+		expectedPanic bool
+	}{
+		{
+			name:     "successful retrieval",
+			username: "testuser",
+			count:    2,
+			mockGists: []domain.Gist{
+				{Description: "gist1"},
+				{Description: "gist2"},
+			},
+		},
+		{
+			name:          "panics on error",
+			username:      "testuser",
+			count:         2,
+			mockError:     errors.New("api error"),
+			expectedPanic: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGH := new(MockGithubPort)
+			mockGH.On("Gists", mock.Anything, tt.username, tt.count).
+				Return(tt.mockGists, tt.mockError)
+
+			svc := New(mockGH, tt.username)
+
+			if tt.expectedPanic {
+				assert.Panics(t, func() {
+					svc.Gists(tt.count)
+				})
+				return
+			}
+
+			result := svc.Gists(tt.count)
+
+			assert.Equal(t, tt.mockGists, result)
+			mockGH.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_RecentStars(t *testing.T) {
+	tests := []struct {
+		name          string
+		username      string
+		count         int
+		mockStars     []domain.Star
+		mockError     error
+		expectedPanic bool
+	}{
+		{
+			name:     "successful retrieval",
+			username: "testuser",
+			count:    2,
+			mockStars: []domain.Star{
+				{Repo: domain.Repo{Name: "repo1"}},
+				{Repo: domain.Repo{Name: "repo2"}},
+			},
+		},
+		{
+			name:          "panics on error",
+			username:      "testuser",
+			count:         2,
+			mockError:     errors.New("api error"),
+			expectedPanic: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGH := new(MockGithubPort)
+			mockGH.On("RecentStars", mock.Anything, tt.username, tt.count).
+				Return(tt.mockStars, tt.mockError)
+
+			svc := New(mockGH, tt.username)
+
+			if tt.expectedPanic {
+				assert.Panics(t, func() {
+					svc.RecentStars(tt.count)
+				})
+				return
+			}
+
+			result := svc.RecentStars(tt.count)
+
+			assert.Equal(t, tt.mockStars, result)
+			mockGH.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_RecentIssues(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-1 * time.Hour)
+
+	tests := []struct {
+		name           string
+		username       string
+		count          int
+		mockIssues     []domain.Issue
+		mockError      error
+		expectedLen    int
+		expectedPanic  bool
+		expectedResult []domain.Issue
+	}{
+		{
+			name:     "filters meta repo and private repos, sorts by time",
+			username: "testuser",
+			count:    2,
+			mockIssues: []domain.Issue{
+				{Repo: domain.Repo{Name: "testuser/repo1", IsPrivate: false}, OccurredAt: earlier},
+				{Repo: domain.Repo{Name: "testuser/testuser", IsPrivate: false}, OccurredAt: now}, // meta - filtered
+				{Repo: domain.Repo{Name: "testuser/repo2", IsPrivate: true}, OccurredAt: now},     // private - filtered
+				{Repo: domain.Repo{Name: "testuser/repo3", IsPrivate: false}, OccurredAt: now},
+			},
+			expectedLen: 2,
+			expectedResult: []domain.Issue{
+				{Repo: domain.Repo{Name: "testuser/repo3", IsPrivate: false}, OccurredAt: now},
+				{Repo: domain.Repo{Name: "testuser/repo1", IsPrivate: false}, OccurredAt: earlier},
+			},
+		},
+		{
+			name:          "panics on error",
+			username:      "testuser",
+			count:         2,
+			mockError:     errors.New("api error"),
+			expectedPanic: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGH := new(MockGithubPort)
+			mockGH.On("RecentIssues", mock.Anything, tt.username, tt.count, []string(nil)).
+				Return(tt.mockIssues, tt.mockError)
+
+			svc := New(mockGH, tt.username)
+
+			if tt.expectedPanic {
+				assert.Panics(t, func() {
+					svc.RecentIssues(tt.count)
+				})
+				return
+			}
+
+			result := svc.RecentIssues(tt.count)
+
+			assert.Len(t, result, tt.expectedLen)
+			if tt.expectedResult != nil {
+				assert.Equal(t, tt.expectedResult, result)
+			}
+			mockGH.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_RecentIssues_States(t *testing.T) {
+	mockGH := new(MockGithubPort)
+	open := []domain.Issue{
+		{Number: 3, State: "OPEN", URL: "https://github.com/other/repo/issues/3", Repo: domain.Repo{Name: "other/repo"}},
+	}
+	mockGH.On("RecentIssues", mock.Anything, "testuser", 5, []string{"OPEN"}).
+		Return(open, nil)
+
+	svc := New(mockGH, "testuser")
+	result := svc.RecentIssues(5, "Open")
+
+	assert.Equal(t, open, result)
+	mockGH.AssertExpectations(t)
+}
+
+func TestService_RecentIssues_PanicsOnUnknownState(t *testing.T) {
+	svc := New(new(MockGithubPort), "testuser")
+
+	assert.Panics(t, func() {
+		svc.RecentIssues(5, "merged")
+	})
+}
+
+func TestService_RecentReviews(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-1 * time.Hour)
+
+	tests := []struct {
+		name           string
+		username       string
+		count          int
+		mockReviews    []domain.Review
+		mockError      error
+		expectedPanic  bool
+		expectedResult []domain.Review
+	}{
+		{
+			name:     "filters meta repo and private repos, sorts by time",
+			username: "testuser",
+			count:    2,
+			mockReviews: []domain.Review{
+				{State: "COMMENTED", PullRequest: domain.PullRequest{Repo: domain.Repo{Name: "org/repo1"}}, OccurredAt: earlier},
+				{State: "APPROVED", PullRequest: domain.PullRequest{Repo: domain.Repo{Name: "testuser/testuser"}}, OccurredAt: now},           // meta - filtered
+				{State: "APPROVED", PullRequest: domain.PullRequest{Repo: domain.Repo{Name: "org/secret", IsPrivate: true}}, OccurredAt: now}, // private - filtered
+				{State: "CHANGES_REQUESTED", PullRequest: domain.PullRequest{Repo: domain.Repo{Name: "org/repo2"}}, OccurredAt: now},
+			},
+			expectedResult: []domain.Review{
+				{State: "CHANGES_REQUESTED", PullRequest: domain.PullRequest{Repo: domain.Repo{Name: "org/repo2"}}, OccurredAt: now},
+				{State: "COMMENTED", PullRequest: domain.PullRequest{Repo: domain.Repo{Name: "org/repo1"}}, OccurredAt: earlier},
+			},
+		},
+		{
+			name:          "panics on error",
+			username:      "testuser",
+			count:         2,
+			mockError:     errors.New("api error"),
+			expectedPanic: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGH := new(MockGithubPort)
+			mockGH.On("RecentReviews", mock.Anything, tt.username, tt.count).
+				Return(tt.mockReviews, tt.mockError)
+
+			svc := New(mockGH, tt.username)
+
+			if tt.expectedPanic {
+				assert.Panics(t, func() {
+					svc.RecentReviews(tt.count)
+				})
+				return
+			}
+
+			result := svc.RecentReviews(tt.count)
+
+			assert.Equal(t, tt.expectedResult, result)
+			mockGH.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_RecentDiscussions(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-1 * time.Hour)
+
+	tests := []struct {
+		name            string
+		method          string
+		username        string
+		count           int
+		mockDiscussions []domain.Discussion
+		mockError       error
+		expectedPanic   bool
+		expectedResult  []domain.Discussion
+	}{
+		{
+			name:     "discussions filter meta repo and private repos, sort by time",
+			method:   "RecentDiscussions",
+			username: "testuser",
+			count:    2,
+			mockDiscussions: []domain.Discussion{
+				{Title: "old", Category: "Q&A", Repo: domain.Repo{Name: "org/repo1"}, CreatedAt: earlier},
+				{Title: "meta", Repo: domain.Repo{Name: "testuser/testuser"}, CreatedAt: now},             // meta - filtered
+				{Title: "secret", Repo: domain.Repo{Name: "org/secret", IsPrivate: true}, CreatedAt: now}, // private - filtered
+				{Title: "new", Category: "Ideas", IsAnswered: true, Repo: domain.Repo{Name: "org/repo2"}, CreatedAt: now},
+			},
+			expectedResult: []domain.Discussion{
+				{Title: "new", Category: "Ideas", IsAnswered: true, Repo: domain.Repo{Name: "org/repo2"}, CreatedAt: now},
+				{Title: "old", Category: "Q&A", Repo: domain.Repo{Name: "org/repo1"}, CreatedAt: earlier},
+			},
+		},
+		{
+			name:     "comments limit to count",
+			method:   "RecentDiscussionComments",
+			username: "testuser",
+			count:    1,
+			mockDiscussions: []domain.Discussion{
+				{Title: "second", Repo: domain.Repo{Name: "org/repo1"}, CreatedAt: now},
+				{Title: "first", Repo: domain.Repo{Name: "org/repo1"}, CreatedAt: earlier},
+			},
+			expectedResult: []domain.Discussion{
+				{Title: "second", Repo: domain.Repo{Name: "org/repo1"}, CreatedAt: now},
+			},
+		},
+		{
+			name:          "discussions panic on error",
+			method:        "RecentDiscussions",
+			username:      "testuser",
+			count:         2,
+			mockError:     errors.New("api error"),
+			expectedPanic: true,
+		},
+		{
+			name:          "comments panic on error",
+			method:        "RecentDiscussionComments",
+			username:      "testuser",
+			count:         2,
+			mockError:     errors.New("api error"),
+			expectedPanic: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGH := new(MockGithubPort)
+			mockGH.On(tt.method, mock.Anything, tt.username, tt.count).
+				Return(tt.mockDiscussions, tt.mockError)
+
+			svc := New(mockGH, tt.username)
+			call := svc.RecentDiscussions
+			if tt.method == "RecentDiscussionComments" {
+				call = svc.RecentDiscussionComments
+			}
+
+			if tt.expectedPanic {
+				assert.Panics(t, func() {
+					call(tt.count)
+				})
+				return
+			}
+
+			result := call(tt.count)
+
+			assert.Equal(t, tt.expectedResult, result)
+			mockGH.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_Sponsors(t *testing.T) {
+	tests := []struct {
+		name           string
+		username       string
+		count          int
+		mockSponsors   []domain.Sponsor
 		mockError      error
 		expectedLen    int
 		expectedPanic  bool
-		expectedResult []domain.PullRequest
+		expectedResult []domain.Sponsor
 	}{
 		{
-			name:     "filters meta repo and private repos",
+			name:     "successful retrieval",
 			username: "testuser",
 			count:    2,
-			mockPRs: []domain.PullRequest{
-				{Repo: domain.Repo{Name: "testuser/repo1", IsPrivate: false}},
-				{Repo: domain.Repo{Name: "testuser/testuser", IsPrivate: false}}, // meta - filtered
-				{Repo: domain.Repo{Name: "testuser/repo2", IsPrivate: true}},     // private - filtered
-				{Repo: domain.Repo{Name: "testuser/repo3", IsPrivate: false}},
+			mockSponsors: []domain.Sponsor{
+				{User: domain.User{Login: "sponsor1"}, Tier: domain.SponsorTier{Name: "Gold", MonthlyPriceInDollars: 100}},
+				{User: domain.User{Login: "sponsor2"}, IsOneTimePayment: true},
 			},
 			expectedLen: 2,
-			expectedResult: []domain.PullRequest{
-				{Repo: domain.Repo{Name: "testuser/repo1", IsPrivate: false}},
-				{Repo: domain.Repo{Name: "testuser/repo3", IsPrivate: false}},
+			expectedResult: []domain.Sponsor{
+				{User: domain.User{Login: "sponsor1"}, Tier: domain.SponsorTier{Name: "Gold", MonthlyPriceInDollars: 100}},
+				{User: domain.User{Login: "sponsor2"}, IsOneTimePayment: true},
+			},
+		},
+		{
+			name:     "limits to count when more returned",
+			username: "testuser",
+			count:    2,
+			mockSponsors: []domain.Sponsor{
+				{User: domain.User{Login: "sponsor1"}},
+				{User: domain.User{Login: "sponsor2"}},
+				{User: domain.User{Login: "sponsor3"}},
+			},
+			expectedLen: 2,
+			expectedResult: []domain.Sponsor{
+				{User: domain.User{Login: "sponsor1"}},
+				{User: domain.User{Login: "sponsor2"}},
 			},
 		},
 		{
@@ -375,19 +1932,19 @@ func TestService_RecentPullRequests(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockGH := new(MockGithubPort)
-			mockGH.On("RecentPullRequests", mock.Anything, tt.username, tt.count+1).
-				Return(tt.mockPRs, tt.mockError)
+			mockGH.On("Sponsors", mock.Anything, tt.username, tt.count).
+				Return(tt.mockSponsors, tt.mockError)
 
 			svc := New(mockGH, tt.username)
 
 			if tt.expectedPanic {
 				assert.Panics(t, func() {
-					svc.RecentPullRequests(tt.count)
+					svc.Sponsors(tt.count)
 				})
 				return
 			}
 
-			result := svc.RecentPullRequests(tt.count)
+			result := svc.Sponsors(tt.count)
 
 			assert.Len(t, result, tt.expectedLen)
 			assert.Equal(t, tt.expectedResult, result)
@@ -396,51 +1953,29 @@ func TestService_RecentPullRequests(t *testing.T) {
 	}
 }
 
-func TestService_RecentReleases(t *testing.T) {
-	now := time.Now()
-	earlier := now.Add(-1 * time.Hour)
-
+func TestService_SponsorsGoal(t *testing.T) {
 	tests := []struct {
-		name           string
-		username       string
-		count          int
-		mockRepos      []domain.Repo
-		mockError      error
-		expectedLen    int
-		expectedPanic  bool
-		expectedResult []domain.Repo
+		name          string
+		mockGoal      domain.SponsorsGoal
+		mockError     error
+		expectedPanic bool
 	}{
 		{
-			name:     "sorts by PublishedAt desc, then Stargazers",
-			username: "testuser",
-			count:    3,
-			mockRepos: []domain.Repo{
-				{Name: "repo1", LastRelease: domain.Release{PublishedAt: earlier}, Stargazers: 10},
-				{Name: "repo2", LastRelease: domain.Release{PublishedAt: now}, Stargazers: 5},
-				{Name: "repo3", LastRelease: domain.Release{PublishedAt: now}, Stargazers: 15},
-			},
-			expectedLen: 3,
-			expectedResult: []domain.Repo{
-				{Name: "repo3", LastRelease: domain.Release{PublishedAt: now}, Stargazers: 15},
-				{Name: "repo2", LastRelease: domain.Release{PublishedAt: now}, Stargazers: 5},
-				{Name: "repo1", LastRelease: domain.Release{PublishedAt: earlier}, Stargazers: 10},
+			name: "returns active goal",
+			mockGoal: domain.SponsorsGoal{
+				Kind:            "MONTHLY_SPONSORSHIP_AMOUNT",
+				Title:           "Full-time open source",
+				PercentComplete: 42,
+				TargetValue:     5000,
 			},
 		},
 		{
-			name:     "limits to count",
-			username: "testuser",
-			count:    2,
-			mockRepos: []domain.Repo{
-				{Name: "repo1", LastRelease: domain.Release{PublishedAt: now}},
-				{Name: "repo2", LastRelease: domain.Release{PublishedAt: now.Add(-1 * time.Hour)}},
-				{Name: "repo3", LastRelease: domain.Release{PublishedAt: now.Add(-2 * time.Hour)}},
-			},
-			expectedLen: 2,
+			name:     "returns zero value without goal",
+			mockGoal: domain.SponsorsGoal{},
 		},
 		{
 			name:          "panics on error",
-			username:      "testuser",
-			count:         2,
+			mockGoal:      domain.SponsorsGoal{},
 			mockError:     errors.New("api error"),
 			expectedPanic: true,
 		},
@@ -449,62 +1984,41 @@ func TestService_RecentReleases(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockGH := new(MockGithubPort)
-			mockGH.On("RecentReleases", mock.Anything, tt.username, tt.count).
-				Return(tt.mockRepos, tt.mockError)
+			mockGH.On("SponsorsGoal", mock.Anything, "testuser").
+				Return(tt.mockGoal, tt.mockError)
 
-			svc := New(mockGH, tt.username)
+			svc := New(mockGH, "testuser")
 
 			if tt.expectedPanic {
 				assert.Panics(t, func() {
-					svc.RecentReleases(tt.count)
+					svc.SponsorsGoal()
 				})
 				return
 			}
 
-			result := svc.RecentReleases(tt.count)
+			result := svc.SponsorsGoal()
 
-			assert.Len(t, result, tt.expectedLen)
-			if tt.expectedResult != nil {
-				assert.Equal(t, tt.expectedResult, result)
-			}
+			assert.Equal(t, tt.mockGoal, result)
 			mockGH.AssertExpectations(t)
 		})
 	}
 }
 
-func TestService_RecentContributions(t *testing.T) {
-	now := time.Now()
-	earlier := now.Add(-1 * time.Hour)
-
+func TestService_Sponsoring(t *testing.T) {
 	tests := []struct {
-		name              string
-		username          string
-		count             int
-		mockContributions []domain.Contribution
-		mockError         error
-		expectedLen       int
-		expectedPanic     bool
-		expectedResult    []domain.Contribution
+		name          string
+		count         int
+		mockUsers     []domain.User
+		mockError     error
+		expectedPanic bool
 	}{
 		{
-			name:     "filters meta repo and private repos, sorts by time",
-			username: "testuser",
-			count:    2,
-			mockContributions: []domain.Contribution{
-				{Repo: domain.Repo{Name: "testuser/repo1", IsPrivate: false}, OccurredAt: earlier},
-				{Repo: domain.Repo{Name: "testuser/testuser", IsPrivate: false}, OccurredAt: now}, // meta - filtered
-				{Repo: domain.Repo{Name: "testuser/repo2", IsPrivate: true}, OccurredAt: now},     // private - filtered
-				{Repo: domain.Repo{Name: "testuser/repo3", IsPrivate: false}, OccurredAt: now},
-			},
-			expectedLen: 2,
-			expectedResult: []domain.Contribution{
-				{Repo: domain.Repo{Name: "testuser/repo3", IsPrivate: false}, OccurredAt: now},
-				{Repo: domain.Repo{Name: "testuser/repo1", IsPrivate: false}, OccurredAt: earlier},
-			},
+			name:      "successful retrieval",
+			count:     2,
+			mockUsers: []domain.User{{Login: "maintainer"}, {Login: "some-org"}},
 		},
 		{
 			name:          "panics on error",
-			username:      "testuser",
 			count:         2,
 			mockError:     errors.New("api error"),
 			expectedPanic: true,
@@ -514,51 +2028,45 @@ func TestService_RecentContributions(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockGH := new(MockGithubPort)
-			mockGH.On("RecentContributions", mock.Anything, tt.username, tt.count+10).
-				Return(tt.mockContributions, tt.mockError)
+			mockGH.On("Sponsoring", mock.Anything, "testuser", tt.count).
+				Return(tt.mockUsers, tt.mockError)
 
-			svc := New(mockGH, tt.username)
+			svc := New(mockGH, "testuser")
 
 			if tt.expectedPanic {
 				assert.Panics(t, func() {
-					svc.RecentContributions(tt.count)
+					svc.Sponsoring(tt.count)
 				})
 				return
 			}
 
-			result := svc.RecentContributions(tt.count)
+			result := svc.Sponsoring(tt.count)
 
-			assert.Len(t, result, tt.expectedLen)
-			if tt.expectedResult != nil {
-				assert.Equal(t, tt.expectedResult, result)
-			}
+			assert.Equal(t, tt.mockUsers, result)
 			mockGH.AssertExpectations(t)
 		})
 	}
 }
 
-func TestService_Gists(t *testing.T) {
+func TestService_PinnedRepos(t *testing.T) {
 	tests := []struct {
 		name          string
 		username      string
-		count         int
-		mockGists     []domain.Gist
+		mockRepos     []domain.Repo
 		mockError     error
 		expectedPanic bool
 	}{
 		{
-			name:     "successful retrieval",
+			name:     "returns pinned repos in order",
 			username: "testuser",
-			count:    2,
-			mockGists: []domain.Gist{
-				{Description: "gist1"},
-				{Description: "gist2"},
+			mockRepos: []domain.Repo{
+				{Name: "testuser/repo2"},
+				{Name: "otherorg/repo1"},
 			},
 		},
 		{
 			name:          "panics on error",
 			username:      "testuser",
-			count:         2,
 			mockError:     errors.New("api error"),
 			expectedPanic: true,
 		},
@@ -567,48 +2075,45 @@ func TestService_Gists(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockGH := new(MockGithubPort)
-			mockGH.On("Gists", mock.Anything, tt.username, tt.count).
-				Return(tt.mockGists, tt.mockError)
+			mockGH.On("PinnedRepos", mock.Anything, tt.username).
+				Return(tt.mockRepos, tt.mockError)
 
 			svc := New(mockGH, tt.username)
 
 			if tt.expectedPanic {
 				assert.Panics(t, func() {
-					svc.Gists(tt.count)
+					svc.PinnedRepos()
 				})
 				return
 			}
 
-			result := svc.Gists(tt.count)
+			result := svc.PinnedRepos()
 
-			assert.Equal(t, tt.mockGists, result)
+			assert.Equal(t, tt.mockRepos, result)
 			mockGH.AssertExpectations(t)
 		})
 	}
 }
 
-func TestService_RecentStars(t *testing.T) {
+func TestService_PinnedGists(t *testing.T) {
 	tests := []struct {
 		name          string
 		username      string
-		count         int
-		mockStars     []domain.Star
+		mockGists     []domain.Gist
 		mockError     error
 		expectedPanic bool
 	}{
 		{
-			name:     "successful retrieval",
+			name:     "returns pinned gists in order",
 			username: "testuser",
-			count:    2,
-			mockStars: []domain.Star{
-				{Repo: domain.Repo{Name: "repo1"}},
-				{Repo: domain.Repo{Name: "repo2"}},
+			mockGists: []domain.Gist{
+				{Name: "abc", Description: "gist1"},
+				{Name: "def", Description: "gist2"},
 			},
 		},
 		{
 			name:          "panics on error",
 			username:      "testuser",
-			count:         2,
 			mockError:     errors.New("api error"),
 			expectedPanic: true,
 		},
@@ -617,59 +2122,52 @@ func TestService_RecentStars(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockGH := new(MockGithubPort)
-			mockGH.On("RecentStars", mock.Anything, tt.username, tt.count).
-				Return(tt.mockStars, tt.mockError)
+			mockGH.On("PinnedGists", mock.Anything, tt.username).
+				Return(tt.mockGists, tt.mockError)
 
 			svc := New(mockGH, tt.username)
 
 			if tt.expectedPanic {
 				assert.Panics(t, func() {
-					svc.RecentStars(tt.count)
+					svc.PinnedGists()
 				})
 				return
 			}
 
-			result := svc.RecentStars(tt.count)
+			result := svc.PinnedGists()
 
-			assert.Equal(t, tt.mockStars, result)
+			assert.Equal(t, tt.mockGists, result)
 			mockGH.AssertExpectations(t)
 		})
 	}
 }
 
-func TestService_RecentIssues(t *testing.T) {
+func TestService_Activity(t *testing.T) {
 	now := time.Now()
-	earlier := now.Add(-1 * time.Hour)
-
 	tests := []struct {
 		name           string
-		username       string
 		count          int
-		mockIssues     []domain.Issue
+		mockActivity   []domain.Activity
 		mockError      error
-		expectedLen    int
 		expectedPanic  bool
-		expectedResult []domain.Issue
+		expectedResult []domain.Activity
 	}{
 		{
-			name:     "filters meta repo and private repos, sorts by time",
-			username: "testuser",
-			count:    2,
-			mockIssues: []domain.Issue{
-				{Repo: domain.Repo{Name: "testuser/repo1", IsPrivate: false}, OccurredAt: earlier},
-				{Repo: domain.Repo{Name: "testuser/testuser", IsPrivate: false}, OccurredAt: now}, // meta - filtered
-				{Repo: domain.Repo{Name: "testuser/repo2", IsPrivate: true}, OccurredAt: now},     // private - filtered
-				{Repo: domain.Repo{Name: "testuser/repo3", IsPrivate: false}, OccurredAt: now},
+			name:  "excludes meta repo, sorts and limits",
+			count: 2,
+			mockActivity: []domain.Activity{
+				{Verb: "pushed", Repo: domain.Repo{Name: "testuser/testuser"}, CreatedAt: now},
+				{Verb: "starred", Repo: domain.Repo{Name: "oss/lib"}, CreatedAt: now.Add(-2 * time.Hour)},
+				{Verb: "opened", Repo: domain.Repo{Name: "testuser/app"}, CreatedAt: now.Add(-time.Hour)},
+				{Verb: "forked", Repo: domain.Repo{Name: "oss/tool"}, CreatedAt: now.Add(-3 * time.Hour)},
 			},
-			expectedLen: 2,
-			expectedResult: []domain.Issue{
-				{Repo: domain.Repo{Name: "testuser/repo3", IsPrivate: false}, OccurredAt: now},
-				{Repo: domain.Repo{Name: "testuser/repo1", IsPrivate: false}, OccurredAt: earlier},
+			expectedResult: []domain.Activity{
+				{Verb: "opened", Repo: domain.Repo{Name: "testuser/app"}, CreatedAt: now.Add(-time.Hour)},
+				{Verb: "starred", Repo: domain.Repo{Name: "oss/lib"}, CreatedAt: now.Add(-2 * time.Hour)},
 			},
 		},
 		{
 			name:          "panics on error",
-			username:      "testuser",
 			count:         2,
 			mockError:     errors.New("api error"),
 			expectedPanic: true,
@@ -679,98 +2177,115 @@ func TestService_RecentIssues(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockGH := new(MockGithubPort)
-			mockGH.On("RecentIssues", mock.Anything, tt.username, tt.count+10).
-				Return(tt.mockIssues, tt.mockError)
+			mockGH.On("Activity", mock.Anything, "testuser", tt.count).
+				Return(tt.mockActivity, tt.mockError)
 
-			svc := New(mockGH, tt.username)
+			svc := New(mockGH, "testuser")
 
 			if tt.expectedPanic {
 				assert.Panics(t, func() {
-					svc.RecentIssues(tt.count)
+					svc.Activity(tt.count)
 				})
 				return
 			}
 
-			result := svc.RecentIssues(tt.count)
+			result := svc.Activity(tt.count)
 
-			assert.Len(t, result, tt.expectedLen)
-			if tt.expectedResult != nil {
-				assert.Equal(t, tt.expectedResult, result)
-			}
+			assert.Equal(t, tt.expectedResult, result)
 			mockGH.AssertExpectations(t)
 		})
 	}
 }
 
-func TestService_Sponsors(t *testing.T) {
+func TestService_WorkflowRuns(t *testing.T) {
 	tests := []struct {
-		name           string
-		username       string
-		count          int
-		mockSponsors   []domain.Sponsor
-		mockError      error
-		expectedLen    int
-		expectedPanic  bool
-		expectedResult []domain.Sponsor
+		name          string
+		repo          string
+		count         int
+		mockRuns      []domain.WorkflowRun
+		mockError     error
+		expectedPanic bool
 	}{
 		{
 			name:     "successful retrieval",
-			username: "testuser",
-			count:    2,
-			mockSponsors: []domain.Sponsor{
-				{User: domain.User{Login: "sponsor1"}},
-				{User: domain.User{Login: "sponsor2"}},
-			},
-			expectedLen: 2,
-			expectedResult: []domain.Sponsor{
-				{User: domain.User{Login: "sponsor1"}},
-				{User: domain.User{Login: "sponsor2"}},
-			},
-		},
-		{
-			name:     "limits to count when more returned",
-			username: "testuser",
+			repo:     "owner/repo",
 			count:    2,
-			mockSponsors: []domain.Sponsor{
-				{User: domain.User{Login: "sponsor1"}},
-				{User: domain.User{Login: "sponsor2"}},
-				{User: domain.User{Login: "sponsor3"}},
-			},
-			expectedLen: 2,
-			expectedResult: []domain.Sponsor{
-				{User: domain.User{Login: "sponsor1"}},
-				{User: domain.User{Login: "sponsor2"}},
-			},
+			mockRuns: []domain.WorkflowRun{{Name: "CI", Conclusion: "success"}, {Name: "Release", Conclusion: "failure"}},
 		},
 		{
 			name:          "panics on error",
-			username:      "testuser",
+			repo:          "owner/repo",
 			count:         2,
 			mockError:     errors.New("api error"),
 			expectedPanic: true,
 		},
+		{
+			name:          "panics on invalid repository",
+			repo:          "owner",
+			count:         2,
+			expectedPanic: true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockGH := new(MockGithubPort)
-			mockGH.On("Sponsors", mock.Anything, tt.username, tt.count).
-				Return(tt.mockSponsors, tt.mockError)
+			mockGH.On("WorkflowRuns", mock.Anything, "owner", "repo", tt.count).
+				Return(tt.mockRuns, tt.mockError).Maybe()
 
-			svc := New(mockGH, tt.username)
+			svc := New(mockGH, "testuser")
 
 			if tt.expectedPanic {
 				assert.Panics(t, func() {
-					svc.Sponsors(tt.count)
+					svc.WorkflowRuns(tt.repo, tt.count)
 				})
 				return
 			}
 
-			result := svc.Sponsors(tt.count)
+			result := svc.WorkflowRuns(tt.repo, tt.count)
 
-			assert.Len(t, result, tt.expectedLen)
-			assert.Equal(t, tt.expectedResult, result)
+			assert.Equal(t, tt.mockRuns, result)
 			mockGH.AssertExpectations(t)
 		})
 	}
 }
+
+func TestService_WorkflowStatus(t *testing.T) {
+	mockGH := new(MockGithubPort)
+	run := domain.WorkflowRun{Name: "CI", Conclusion: "success", Branch: "main", Duration: time.Minute}
+	mockGH.On("LatestWorkflowRun", mock.Anything, "owner", "repo", "ci.yml").Return(run, nil)
+
+	assert.Equal(t, run, New(mockGH, "testuser").WorkflowStatus("owner/repo", "ci.yml"))
+	mockGH.AssertExpectations(t)
+
+	failing := new(MockGithubPort)
+	failing.On("LatestWorkflowRun", mock.Anything, "owner", "repo", "ci.yml").Return(domain.WorkflowRun{}, errors.New("api error"))
+
+	assert.Panics(t, func() {
+		New(failing, "testuser").WorkflowStatus("owner/repo", "ci.yml")
+	})
+}
+
+func TestService_WithRules(t *testing.T) {
+	mockGH := new(MockGithubPort)
+	mockGH.On("RecentRepos", mock.Anything, "testuser", 3, false).Return([]domain.Repo{
+		{Name: "testuser/testuser"},
+		{Name: "testuser/sandbox-1", Topics: []string{"sandbox"}},
+		{Name: "testuser/app"},
+		{Name: "testuser/old", IsArchived: true},
+	}, nil)
+	mockGH.On("RecentContributions", mock.Anything, "testuser", 2).Return([]domain.Contribution{
+		{Repo: domain.Repo{Name: "testuser/sandbox-2"}},
+		{Repo: domain.Repo{Name: "testuser/app"}},
+	}, nil)
+
+	svc := New(mockGH, "testuser", WithFilter(forgesvc.WithRules(forgesvc.Rules{
+		Exclude:         []string{"testuser/sandbox-*"},
+		ExcludeTopics:   []string{"sandbox"},
+		ExcludeArchived: true,
+	})))
+
+	assert.Equal(t, []domain.Repo{{Name: "testuser/app"}}, svc.RecentRepos(3))
+	assert.Equal(t, []domain.Contribution{{Repo: domain.Repo{Name: "testuser/app"}}}, svc.RecentContributions(2))
+	mockGH.AssertExpectations(t)
+}
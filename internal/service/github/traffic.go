@@ -0,0 +1,65 @@
+package github
+
+import (
+	"context"
+	"sort"
+
+	domain "hufschlaeger.net/markscribe/internal/domain"
+	"hufschlaeger.net/markscribe/internal/usecase/ports"
+)
+
+// WithTrafficHistory accumulates repository traffic in store across runs.
+func WithTrafficHistory(store ports.TrafficHistoryPort) Option {
+	return func(s *Service) { s.traffic = store }
+}
+
+// Traffic returns the views, clones, top referrers and popular paths of repo
+// ("owner/name") for the last 14 days. With a traffic history store, the
+// fetched days are merged into the stored ones and Daily, TotalViews,
+// TotalClones and Since cover the whole history.
+func (s *Service) Traffic(repo string) domain.Traffic {
+	owner, name := splitRepo(repo)
+	t, err := s.gh.Traffic(context.Background(), owner, name)
+	if err != nil {
+		panic(err)
+	}
+
+	if s.traffic != nil {
+		stored, err := s.traffic.Load(t.Repo)
+		if err != nil {
+			panic(err)
+		}
+		t.Daily = mergeTrafficDays(stored, t.Daily)
+		if err := s.traffic.Save(t.Repo, t.Daily); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, d := range t.Daily {
+		t.TotalViews += d.Views
+		t.TotalClones += d.Clones
+	}
+	if len(t.Daily) > 0 {
+		t.Since = t.Daily[0].Date
+	}
+	return t
+}
+
+// mergeTrafficDays merges fresh days into stored ones, oldest first. Fresh
+// values replace stored ones of the same day, as GitHub still updates the
+// current day.
+func mergeTrafficDays(stored, fresh []domain.TrafficDay) []domain.TrafficDay {
+	byDate := map[int64]domain.TrafficDay{}
+	for _, d := range stored {
+		byDate[d.Date.Unix()] = d
+	}
+	for _, d := range fresh {
+		byDate[d.Date.Unix()] = d
+	}
+	out := make([]domain.TrafficDay, 0, len(byDate))
+	for _, d := range byDate {
+		out = append(out, d)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Date.Before(out[j].Date) })
+	return out
+}
@@ -0,0 +1,73 @@
+package github
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+type memoryTrafficHistory map[string][]domain.TrafficDay
+
+func (m memoryTrafficHistory) Load(repo string) ([]domain.TrafficDay, error) { return m[repo], nil }
+
+func (m memoryTrafficHistory) Save(repo string, days []domain.TrafficDay) error {
+	m[repo] = days
+	return nil
+}
+
+func day(n int) time.Time { return time.Date(2025, 1, n, 0, 0, 0, 0, time.UTC) }
+
+func TestService_Traffic(t *testing.T) {
+	fresh := domain.Traffic{
+		Repo:  "owner/repo",
+		Views: 15,
+		Daily: []domain.TrafficDay{
+			{Date: day(10), Views: 5, Clones: 1},
+			{Date: day(11), Views: 10, Clones: 2},
+		},
+	}
+
+	t.Run("without history", func(t *testing.T) {
+		mockGH := new(MockGithubPort)
+		mockGH.On("Traffic", mock.Anything, "owner", "repo").Return(fresh, nil)
+
+		result := New(mockGH, "testuser").Traffic("owner/repo")
+
+		assert.Equal(t, 15, result.TotalViews)
+		assert.Equal(t, 3, result.TotalClones)
+		assert.Equal(t, day(10), result.Since)
+		mockGH.AssertExpectations(t)
+	})
+
+	t.Run("accumulates history", func(t *testing.T) {
+		history := memoryTrafficHistory{"owner/repo": {
+			{Date: day(1), Views: 100, Clones: 10},
+			{Date: day(10), Views: 3},
+		}}
+		mockGH := new(MockGithubPort)
+		mockGH.On("Traffic", mock.Anything, "owner", "repo").Return(fresh, nil)
+
+		result := New(mockGH, "testuser", WithTrafficHistory(history)).Traffic("owner/repo")
+
+		expectedDays := []domain.TrafficDay{
+			{Date: day(1), Views: 100, Clones: 10},
+			{Date: day(10), Views: 5, Clones: 1},
+			{Date: day(11), Views: 10, Clones: 2},
+		}
+		assert.Equal(t, expectedDays, result.Daily)
+		assert.Equal(t, expectedDays, history["owner/repo"])
+		assert.Equal(t, 115, result.TotalViews)
+		assert.Equal(t, 13, result.TotalClones)
+		assert.Equal(t, 15, result.Views)
+		assert.Equal(t, day(1), result.Since)
+	})
+
+	t.Run("panics on invalid repository", func(t *testing.T) {
+		assert.Panics(t, func() {
+			New(new(MockGithubPort), "testuser").Traffic("repo")
+		})
+	})
+}
@@ -0,0 +1,126 @@
+package gitlab
+
+import (
+	"context"
+	"sort"
+
+	domain "hufschlaeger.net/markscribe/internal/domain"
+	forgesvc "hufschlaeger.net/markscribe/internal/service/forge"
+	"hufschlaeger.net/markscribe/internal/usecase/ports"
+)
+
+// Service wraps the GitlabPort and contains app-level logic for GitLab features.
+// Results pass through a forge.Filter configured by the options given to New;
+// internal projects count as private.
+type Service struct {
+	gl       ports.GitlabPort
+	username string
+	filter   forgesvc.Filter
+}
+
+func New(gl ports.GitlabPort, username string, opts ...forgesvc.FilterOption) *Service {
+	return &Service{gl: gl, username: username, filter: forgesvc.NewFilter(username, opts...)}
+}
+
+// RecentRepos returns the user's most recently created non-fork projects.
+func (s *Service) RecentRepos(count int) []domain.Repo {
+	return s.recentProjects(count, false)
+}
+
+// RecentForks returns the user's most recently created forks.
+func (s *Service) RecentForks(count int) []domain.Repo {
+	return s.recentProjects(count, true)
+}
+
+func (s *Service) recentProjects(count int, isFork bool) []domain.Repo {
+	repos, err := s.gl.RecentProjects(context.Background(), s.username, count, isFork, s.filter.Allows)
+	if err != nil {
+		panic(err)
+	}
+	return s.filter.RedactRepos(repos)
+}
+
+// RecentMergeRequests returns the user's recent merge requests, sorted by
+// creation date desc and limited to count.
+func (s *Service) RecentMergeRequests(count int) []domain.PullRequest {
+	mrs, err := s.gl.RecentMergeRequests(context.Background(), s.username, count, s.filter.Allows)
+	if err != nil {
+		panic(err)
+	}
+	var out []domain.PullRequest
+	for _, mr := range mrs {
+		out = append(out, s.filter.RedactPullRequest(mr))
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].CreatedAt.After(out[j].CreatedAt) })
+	return out
+}
+
+// RecentIssues returns the user's recent issues, sorted by time desc and limited to count.
+func (s *Service) RecentIssues(count int) []domain.Issue {
+	issues, err := s.gl.RecentIssues(context.Background(), s.username, count, s.filter.Allows)
+	if err != nil {
+		panic(err)
+	}
+	var out []domain.Issue
+	for _, is := range issues {
+		out = append(out, s.filter.RedactIssue(is))
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].OccurredAt.After(out[j].OccurredAt) })
+	return out
+}
+
+// RecentReleases returns projects with the most recent releases, sorted by
+// PublishedAt desc, then Stargazers desc, limited to count.
+func (s *Service) RecentReleases(count int) []domain.Repo {
+	repos, err := s.gl.RecentReleases(context.Background(), s.username, count, s.filter.Allows)
+	if err != nil {
+		panic(err)
+	}
+	repos = s.filter.RedactRepos(repos)
+	sort.SliceStable(repos, func(i, j int) bool {
+		if repos[i].LastRelease.PublishedAt.Equal(repos[j].LastRelease.PublishedAt) {
+			return repos[i].Stargazers > repos[j].Stargazers
+		}
+		return repos[i].LastRelease.PublishedAt.After(repos[j].LastRelease.PublishedAt)
+	})
+	return repos
+}
+
+// RecentStars returns projects starred by the user, limited to count.
+func (s *Service) RecentStars(count int) []domain.Star {
+	stars, err := s.gl.RecentStars(context.Background(), s.username, count, s.filter.Allows)
+	if err != nil {
+		panic(err)
+	}
+	var out []domain.Star
+	for _, st := range stars {
+		st.Repo = s.filter.Redact(st.Repo)
+		out = append(out, st)
+	}
+	return out
+}
+
+// RecentContributions returns the projects the user recently pushed to,
+// sorted by time desc and limited to count.
+func (s *Service) RecentContributions(count int) []domain.Contribution {
+	cons, err := s.gl.RecentContributions(context.Background(), s.username, count, s.filter.Allows)
+	if err != nil {
+		panic(err)
+	}
+	var out []domain.Contribution
+	for _, c := range cons {
+		c.Repo = s.filter.Redact(c.Repo)
+		out = append(out, c)
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].OccurredAt.After(out[j].OccurredAt) })
+	return out
+}
+
+// Groups returns the groups of the authenticated user, up to count.
+func (s *Service) Groups(count int) []domain.User {
+	groups, err := s.gl.Groups(context.Background(), count)
+	if err != nil {
+		panic(err)
+	}
+	return groups
+}
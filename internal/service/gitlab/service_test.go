@@ -0,0 +1,263 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	domain "hufschlaeger.net/markscribe/internal/domain"
+	forgesvc "hufschlaeger.net/markscribe/internal/service/forge"
+)
+
+// keepItems filters items like the adapter does: it skips items whose
+// repository keep rejects and stops at count.
+func keepItems[T any](items []T, count int, keep func(domain.Repo) bool, repo func(T) domain.Repo) []T {
+	var out []T
+	for _, item := range items {
+		if keep != nil && !keep(repo(item)) {
+			continue
+		}
+		if len(out) == count {
+			break
+		}
+		out = append(out, item)
+	}
+	return out
+}
+
+// MockGitlabPort is a mock implementation of ports.GitlabPort
+type MockGitlabPort struct {
+	mock.Mock
+}
+
+func (m *MockGitlabPort) ViewerLogin(ctx context.Context) (string, error) {
+	args := m.Called(ctx)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockGitlabPort) RecentProjects(ctx context.Context, username string, count int, isFork bool, keep func(domain.Repo) bool) ([]domain.Repo, error) {
+	args := m.Called(ctx, username, count, isFork)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return keepItems(args.Get(0).([]domain.Repo), count, keep, func(r domain.Repo) domain.Repo { return r }), args.Error(1)
+}
+
+func (m *MockGitlabPort) RecentMergeRequests(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.PullRequest, error) {
+	args := m.Called(ctx, username, count)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return keepItems(args.Get(0).([]domain.PullRequest), count, keep, func(pr domain.PullRequest) domain.Repo { return pr.Repo }), args.Error(1)
+}
+
+func (m *MockGitlabPort) RecentIssues(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Issue, error) {
+	args := m.Called(ctx, username, count)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return keepItems(args.Get(0).([]domain.Issue), count, keep, func(is domain.Issue) domain.Repo { return is.Repo }), args.Error(1)
+}
+
+func (m *MockGitlabPort) RecentReleases(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Repo, error) {
+	args := m.Called(ctx, username, count)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return keepItems(args.Get(0).([]domain.Repo), count, keep, func(r domain.Repo) domain.Repo { return r }), args.Error(1)
+}
+
+func (m *MockGitlabPort) RecentStars(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Star, error) {
+	args := m.Called(ctx, username, count)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return keepItems(args.Get(0).([]domain.Star), count, keep, func(st domain.Star) domain.Repo { return st.Repo }), args.Error(1)
+}
+
+func (m *MockGitlabPort) RecentContributions(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Contribution, error) {
+	args := m.Called(ctx, username, count)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return keepItems(args.Get(0).([]domain.Contribution), count, keep, func(c domain.Contribution) domain.Repo { return c.Repo }), args.Error(1)
+}
+
+func (m *MockGitlabPort) Groups(ctx context.Context, count int) ([]domain.User, error) {
+	args := m.Called(ctx, count)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.User), args.Error(1)
+}
+
+func TestService_RecentRepos(t *testing.T) {
+	tests := []struct {
+		name           string
+		count          int
+		mockRepos      []domain.Repo
+		mockError      error
+		expectedPanic  bool
+		expectedResult []domain.Repo
+	}{
+		{
+			name:  "excludes meta and private projects",
+			count: 2,
+			mockRepos: []domain.Repo{
+				{Name: "testuser/testuser"},
+				{Name: "testuser/internal", IsPrivate: true},
+				{Name: "testuser/app"},
+				{Name: "testuser/lib"},
+				{Name: "testuser/tool"},
+			},
+			expectedResult: []domain.Repo{{Name: "testuser/app"}, {Name: "testuser/lib"}},
+		},
+		{
+			name:          "panics on error",
+			count:         2,
+			mockError:     errors.New("api error"),
+			expectedPanic: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGL := new(MockGitlabPort)
+			mockGL.On("RecentProjects", mock.Anything, "testuser", tt.count, false).
+				Return(tt.mockRepos, tt.mockError)
+
+			svc := New(mockGL, "testuser")
+
+			if tt.expectedPanic {
+				assert.Panics(t, func() {
+					svc.RecentRepos(tt.count)
+				})
+				return
+			}
+
+			result := svc.RecentRepos(tt.count)
+
+			assert.Equal(t, tt.expectedResult, result)
+			mockGL.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_RecentForks(t *testing.T) {
+	mockGL := new(MockGitlabPort)
+	mockGL.On("RecentProjects", mock.Anything, "testuser", 1, true).
+		Return([]domain.Repo{{Name: "testuser/fork", IsFork: true}}, nil)
+
+	assert.Equal(t, []domain.Repo{{Name: "testuser/fork", IsFork: true}}, New(mockGL, "testuser").RecentForks(1))
+	mockGL.AssertExpectations(t)
+}
+
+func TestService_RecentMergeRequests(t *testing.T) {
+	now := time.Now()
+	mockGL := new(MockGitlabPort)
+	mockGL.On("RecentMergeRequests", mock.Anything, "testuser", 2).Return([]domain.PullRequest{
+		{Title: "old", CreatedAt: now.Add(-2 * time.Hour), Repo: domain.Repo{Name: "group/app"}},
+		{Title: "secret", CreatedAt: now, Repo: domain.Repo{Name: "group/secret", IsPrivate: true}},
+		{Title: "new", CreatedAt: now.Add(-time.Hour), Repo: domain.Repo{Name: "group/app"}},
+	}, nil)
+
+	result := New(mockGL, "testuser").RecentMergeRequests(2)
+
+	assert.Equal(t, []string{"new", "old"}, []string{result[0].Title, result[1].Title})
+	mockGL.AssertExpectations(t)
+}
+
+func TestService_RecentIssues(t *testing.T) {
+	now := time.Now()
+	mockGL := new(MockGitlabPort)
+	mockGL.On("RecentIssues", mock.Anything, "testuser", 2).Return([]domain.Issue{
+		{Title: "a", OccurredAt: now.Add(-time.Hour), Repo: domain.Repo{Name: "group/app"}},
+		{Title: "secret", OccurredAt: now, Repo: domain.Repo{Name: "group/secret", IsPrivate: true}},
+		{Title: "b", OccurredAt: now, Repo: domain.Repo{Name: "group/app"}},
+	}, nil)
+
+	result := New(mockGL, "testuser").RecentIssues(2)
+
+	assert.Equal(t, []string{"b", "a"}, []string{result[0].Title, result[1].Title})
+	mockGL.AssertExpectations(t)
+}
+
+func TestService_RecentReleases(t *testing.T) {
+	now := time.Now()
+	mockGL := new(MockGitlabPort)
+	mockGL.On("RecentReleases", mock.Anything, "testuser", 3).Return([]domain.Repo{
+		{Name: "testuser/a", Stargazers: 1, LastRelease: domain.Release{PublishedAt: now.Add(-time.Hour)}},
+		{Name: "testuser/b", Stargazers: 5, LastRelease: domain.Release{PublishedAt: now}},
+		{Name: "testuser/c", Stargazers: 9, LastRelease: domain.Release{PublishedAt: now.Add(-time.Hour)}},
+	}, nil)
+
+	result := New(mockGL, "testuser").RecentReleases(3)
+
+	assert.Equal(t, []string{"testuser/b", "testuser/c", "testuser/a"}, []string{result[0].Name, result[1].Name, result[2].Name})
+	mockGL.AssertExpectations(t)
+}
+
+func TestService_RecentStars(t *testing.T) {
+	mockGL := new(MockGitlabPort)
+	mockGL.On("RecentStars", mock.Anything, "testuser", 2).Return([]domain.Star{
+		{Repo: domain.Repo{Name: "other/private", IsPrivate: true}},
+		{Repo: domain.Repo{Name: "other/lib"}},
+	}, nil)
+
+	assert.Equal(t, []domain.Star{{Repo: domain.Repo{Name: "other/lib"}}}, New(mockGL, "testuser").RecentStars(2))
+	mockGL.AssertExpectations(t)
+}
+
+func TestService_RecentContributions(t *testing.T) {
+	now := time.Now()
+	mockGL := new(MockGitlabPort)
+	mockGL.On("RecentContributions", mock.Anything, "testuser", 2).Return([]domain.Contribution{
+		{OccurredAt: now.Add(-time.Hour), Repo: domain.Repo{Name: "group/a"}},
+		{OccurredAt: now, Repo: domain.Repo{Name: "group/b"}},
+	}, nil)
+
+	result := New(mockGL, "testuser").RecentContributions(2)
+
+	assert.Equal(t, "group/b", result[0].Repo.Name)
+	assert.Equal(t, "group/a", result[1].Repo.Name)
+	mockGL.AssertExpectations(t)
+}
+
+func TestService_FilterOptions(t *testing.T) {
+	mockGL := new(MockGitlabPort)
+	mockGL.On("RecentProjects", mock.Anything, "testuser", 2, false).Return([]domain.Repo{
+		{Name: "testuser/sandbox"},
+		{Name: "group/secret", URL: "https://gitlab.com/group/secret", IsPrivate: true},
+		{Name: "testuser/app"},
+	}, nil)
+	mockGL.On("RecentMergeRequests", mock.Anything, "testuser", 1).Return([]domain.PullRequest{
+		{Title: "Add SSO", URL: "https://gitlab.com/group/secret/-/merge_requests/1", Repo: domain.Repo{Name: "group/secret", IsPrivate: true}},
+	}, nil)
+
+	svc := New(mockGL, "testuser",
+		forgesvc.WithRules(forgesvc.Rules{Exclude: []string{"testuser/sandbox"}}),
+		forgesvc.WithPrivacy(forgesvc.PrivacyRedact, nil),
+	)
+
+	assert.Equal(t, []domain.Repo{
+		{Name: forgesvc.RedactedRepoName, IsPrivate: true},
+		{Name: "testuser/app"},
+	}, svc.RecentRepos(2))
+	assert.Equal(t, []domain.PullRequest{{Repo: domain.Repo{Name: forgesvc.RedactedRepoName, IsPrivate: true}}}, svc.RecentMergeRequests(1))
+	mockGL.AssertExpectations(t)
+}
+
+func TestService_Groups(t *testing.T) {
+	mockGL := new(MockGitlabPort)
+	mockGL.On("Groups", mock.Anything, 5).Return([]domain.User{{Login: "group", Name: "Group"}}, nil)
+
+	assert.Equal(t, []domain.User{{Login: "group", Name: "Group"}}, New(mockGL, "testuser").Groups(5))
+	mockGL.AssertExpectations(t)
+
+	failing := new(MockGitlabPort)
+	failing.On("Groups", mock.Anything, 5).Return(nil, errors.New("api error"))
+	assert.Panics(t, func() { New(failing, "testuser").Groups(5) })
+}
@@ -0,0 +1,49 @@
+package sourcehut
+
+import (
+	"context"
+	"strings"
+
+	domain "hufschlaeger.net/markscribe/internal/domain"
+	forgesvc "hufschlaeger.net/markscribe/internal/service/forge"
+	"hufschlaeger.net/markscribe/internal/usecase/ports"
+)
+
+// Service wraps the SourcehutPort and contains app-level logic for sourcehut
+// features. Usernames are given without the "~" prefix; repository names
+// carry it ("~user/name"). Results pass through a forge.Filter configured by
+// the options given to New; unlisted repositories and trackers count as
+// private.
+type Service struct {
+	sh       ports.SourcehutPort
+	username string
+	filter   forgesvc.Filter
+}
+
+func New(sh ports.SourcehutPort, username string, opts ...forgesvc.FilterOption) *Service {
+	username = strings.TrimPrefix(username, "~")
+	return &Service{sh: sh, username: username, filter: forgesvc.NewFilter(username, opts...)}
+}
+
+// RecentRepos returns the user's most recently created git repositories.
+func (s *Service) RecentRepos(count int) []domain.Repo {
+	repos, err := s.sh.RecentRepos(context.Background(), s.username, count, s.filter.Allows)
+	if err != nil {
+		panic(err)
+	}
+	return s.filter.RedactRepos(repos)
+}
+
+// RecentTickets returns the most recent tickets on the user's public
+// trackers.
+func (s *Service) RecentTickets(count int) []domain.Issue {
+	tickets, err := s.sh.RecentTickets(context.Background(), s.username, count, s.filter.Allows)
+	if err != nil {
+		panic(err)
+	}
+	var out []domain.Issue
+	for _, t := range tickets {
+		out = append(out, s.filter.RedactIssue(t))
+	}
+	return out
+}
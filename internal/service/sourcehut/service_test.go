@@ -0,0 +1,119 @@
+package sourcehut
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+// keepItems filters items like the adapter does: it skips items whose
+// repository keep rejects and stops at count.
+func keepItems[T any](items []T, count int, keep func(domain.Repo) bool, repo func(T) domain.Repo) []T {
+	var out []T
+	for _, item := range items {
+		if keep != nil && !keep(repo(item)) {
+			continue
+		}
+		if len(out) == count {
+			break
+		}
+		out = append(out, item)
+	}
+	return out
+}
+
+// MockSourcehutPort is a mock implementation of ports.SourcehutPort
+type MockSourcehutPort struct {
+	mock.Mock
+}
+
+func (m *MockSourcehutPort) ViewerLogin(ctx context.Context) (string, error) {
+	args := m.Called(ctx)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockSourcehutPort) RecentRepos(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Repo, error) {
+	args := m.Called(ctx, username, count)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return keepItems(args.Get(0).([]domain.Repo), count, keep, func(r domain.Repo) domain.Repo { return r }), args.Error(1)
+}
+
+func (m *MockSourcehutPort) RecentTickets(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Issue, error) {
+	args := m.Called(ctx, username, count)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return keepItems(args.Get(0).([]domain.Issue), count, keep, func(is domain.Issue) domain.Repo { return is.Repo }), args.Error(1)
+}
+
+func TestService_RecentRepos(t *testing.T) {
+	tests := []struct {
+		name           string
+		count          int
+		mockRepos      []domain.Repo
+		mockError      error
+		expectedPanic  bool
+		expectedResult []domain.Repo
+	}{
+		{
+			name:  "excludes meta and private repositories",
+			count: 2,
+			mockRepos: []domain.Repo{
+				{Name: "~testuser/testuser"},
+				{Name: "~testuser/unlisted", IsPrivate: true},
+				{Name: "~testuser/app"},
+				{Name: "~testuser/lib"},
+				{Name: "~testuser/tool"},
+			},
+			expectedResult: []domain.Repo{{Name: "~testuser/app"}, {Name: "~testuser/lib"}},
+		},
+		{
+			name:          "panics on error",
+			count:         2,
+			mockError:     errors.New("api error"),
+			expectedPanic: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSH := new(MockSourcehutPort)
+			mockSH.On("RecentRepos", mock.Anything, "testuser", tt.count).
+				Return(tt.mockRepos, tt.mockError)
+
+			// The "~" prefix is accepted and stripped.
+			svc := New(mockSH, "~testuser")
+
+			if tt.expectedPanic {
+				assert.Panics(t, func() {
+					svc.RecentRepos(tt.count)
+				})
+				return
+			}
+
+			result := svc.RecentRepos(tt.count)
+
+			assert.Equal(t, tt.expectedResult, result)
+			mockSH.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_RecentTickets(t *testing.T) {
+	mockSH := new(MockSourcehutPort)
+	mockSH.On("RecentTickets", mock.Anything, "testuser", 2).Return([]domain.Issue{
+		{Title: "Rotate keys", Repo: domain.Repo{Name: "~testuser/ops", IsPrivate: true}},
+		{Title: "Crash", Repo: domain.Repo{Name: "~testuser/app"}},
+	}, nil)
+
+	result := New(mockSH, "testuser").RecentTickets(2)
+
+	assert.Equal(t, []domain.Issue{{Title: "Crash", Repo: domain.Repo{Name: "~testuser/app"}}}, result)
+	mockSH.AssertExpectations(t)
+}
@@ -0,0 +1,136 @@
+package template
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+
+	"golang.org/x/oauth2"
+	"hufschlaeger.net/markscribe/internal/infra/credentials"
+	"hufschlaeger.net/markscribe/internal/infra/githubapp"
+)
+
+// githubTokenSource picks the GitHub credentials configured in cfg. GitHub
+// App authentication takes precedence over a personal access token, which in
+// turn may come from any of cfg.GitHubTokenSources. It returns nil when no
+// credentials are found.
+func githubTokenSource(ctx context.Context, cfg Config, client *http.Client) (oauth2.TokenSource, error) {
+	if cfg.GitHubAppID != "" {
+		fmt.Fprintf(os.Stderr, "GitHub credentials: using GitHub App %s\n", cfg.GitHubAppID)
+		return githubAppTokenSource(ctx, cfg, client)
+	}
+
+	token := cfg.GitHubToken
+	if token == "" {
+		host, err := githubHost(cfg)
+		if err != nil {
+			return nil, err
+		}
+		var source string
+		token, source, err = credentials.Resolve(ctx, host, cfg.GitHubTokenSources...)
+		if err != nil {
+			return nil, fmt.Errorf("can't read GitHub token: %w", err)
+		}
+		if token != "" {
+			fmt.Fprintf(os.Stderr, "GitHub credentials: using token from %s\n", source)
+		}
+	}
+	if token == "" {
+		return nil, nil
+	}
+	return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token}), nil
+}
+
+// githubHost returns the host name credentials are stored under.
+func githubHost(cfg Config) (string, error) {
+	if cfg.GitHubURL == "" {
+		return "github.com", nil
+	}
+	u, err := url.Parse(cfg.GitHubURL)
+	if err != nil || u.Host == "" {
+		return "", fmt.Errorf("invalid GitHub GraphQL URL %q", cfg.GitHubURL)
+	}
+	return u.Host, nil
+}
+
+func githubAppTokenSource(ctx context.Context, cfg Config, client *http.Client) (oauth2.TokenSource, error) {
+	appID, err := strconv.ParseInt(cfg.GitHubAppID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid GITHUB_APP_ID %q", cfg.GitHubAppID)
+	}
+	installationID, err := strconv.ParseInt(cfg.GitHubAppInstallationID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid GITHUB_APP_INSTALLATION_ID %q", cfg.GitHubAppInstallationID)
+	}
+
+	pemData := []byte(cfg.GitHubAppPrivateKey)
+	if len(pemData) == 0 && cfg.GitHubAppPrivateKeyFile != "" {
+		pemData, err = os.ReadFile(cfg.GitHubAppPrivateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("can't read GitHub App private key: %w", err)
+		}
+	}
+	if len(pemData) == 0 {
+		return nil, fmt.Errorf("GITHUB_APP_PRIVATE_KEY or GITHUB_APP_PRIVATE_KEY_FILE is required with GitHub App authentication")
+	}
+	key, err := githubapp.ParsePrivateKey(pemData)
+	if err != nil {
+		return nil, err
+	}
+
+	apiURL, err := restAPIURL(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return githubapp.NewTokenSource(ctx, client, apiURL, appID, installationID, key), nil
+}
+
+// restAPIURL returns the REST API root: GitHubAPIURL if set, /api/v3 of a
+// GitHub Enterprise Server if GitHubURL is set, otherwise api.github.com.
+func restAPIURL(cfg Config) (string, error) {
+	if cfg.GitHubAPIURL != "" {
+		return cfg.GitHubAPIURL, nil
+	}
+	if cfg.GitHubURL == "" {
+		return githubapp.DefaultAPIURL, nil
+	}
+	u, err := url.Parse(cfg.GitHubURL)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return "", fmt.Errorf("invalid GitHub GraphQL URL %q", cfg.GitHubURL)
+	}
+	return u.Scheme + "://" + u.Host + "/api/v3", nil
+}
+
+// basicAuthTransport adds HTTP basic auth to every request, as Bitbucket
+// Cloud expects for app passwords.
+type basicAuthTransport struct {
+	username, password string
+	base               http.RoundTripper
+}
+
+func (t basicAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTrippers must not modify the caller's request.
+	req = req.Clone(req.Context())
+	req.SetBasicAuth(t.username, t.password)
+	return t.base.RoundTrip(req)
+}
+
+// bitbucketClient returns an HTTP client authenticating with the Bitbucket
+// credentials in cfg: an access token, or a username with an app password.
+func bitbucketClient(ctx context.Context, cfg Config) *http.Client {
+	switch {
+	case cfg.BitbucketToken != "":
+		return oauth2.NewClient(ctx, oauth2.StaticTokenSource(&oauth2.Token{AccessToken: cfg.BitbucketToken}))
+	case cfg.BitbucketAppPassword != "":
+		return &http.Client{Transport: basicAuthTransport{
+			username: cfg.BitbucketUsername,
+			password: cfg.BitbucketAppPassword,
+			base:     http.DefaultTransport,
+		}}
+	default:
+		return http.DefaultClient
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"reflect"
+	"strconv"
 	"strings"
 	texttmpl "text/template"
 	"time"
@@ -15,15 +17,27 @@ import (
 	"github.com/dustin/go-humanize"
 	"github.com/shurcooL/githubv4"
 	"golang.org/x/oauth2"
+	bitbucketadapter "hufschlaeger.net/markscribe/internal/adapters/bitbucket"
+	forgejoadapter "hufschlaeger.net/markscribe/internal/adapters/forgejo"
 	githubadapter "hufschlaeger.net/markscribe/internal/adapters/github"
+	gitlabadapter "hufschlaeger.net/markscribe/internal/adapters/gitlab"
 	goodreadsadapter "hufschlaeger.net/markscribe/internal/adapters/goodreads"
 	literaladapter "hufschlaeger.net/markscribe/internal/adapters/literal"
 	rssadapter "hufschlaeger.net/markscribe/internal/adapters/rss"
+	sourcehutadapter "hufschlaeger.net/markscribe/internal/adapters/sourcehut"
+	trafficstoreadapter "hufschlaeger.net/markscribe/internal/adapters/trafficstore"
 	domain "hufschlaeger.net/markscribe/internal/domain"
+	"hufschlaeger.net/markscribe/internal/infra/credentials"
+	"hufschlaeger.net/markscribe/internal/infra/httpclient"
+	bitbucketsvc "hufschlaeger.net/markscribe/internal/service/bitbucket"
+	forgesvc "hufschlaeger.net/markscribe/internal/service/forge"
+	forgejosvc "hufschlaeger.net/markscribe/internal/service/forgejo"
 	githubsvc "hufschlaeger.net/markscribe/internal/service/github"
+	gitlabsvc "hufschlaeger.net/markscribe/internal/service/gitlab"
 	goodreadssvc "hufschlaeger.net/markscribe/internal/service/goodreads"
 	literalsvc "hufschlaeger.net/markscribe/internal/service/literal"
 	rsssvc "hufschlaeger.net/markscribe/internal/service/rss"
+	sourcehutsvc "hufschlaeger.net/markscribe/internal/service/sourcehut"
 )
 
 // Service composes per-port services and exposes template-facing API.
@@ -32,42 +46,265 @@ type Service struct {
 	gr  *goodreadssvc.Service
 	lit *literalsvc.Service
 	rss *rsssvc.Service
+	// gl, fj, bb and sh are only set when GitLab, Forgejo, Bitbucket and
+	// sourcehut are configured.
+	gl *gitlabsvc.Service
+	fj *forgejosvc.Service
+	// glAuthenticated is set when GitLab requests carry a token.
+	glAuthenticated bool
+	bb              *bitbucketsvc.Service
+	sh              *sourcehutsvc.Service
 }
 
 func New(gh *githubsvc.Service, gr *goodreadssvc.Service, lit *literalsvc.Service, rss *rsssvc.Service) *Service {
 	return &Service{gh: gh, gr: gr, lit: lit, rss: rss}
 }
 
+// Config holds the settings needed to wire the service's dependencies.
+type Config struct {
+	// GitHubToken is a personal access token. When empty, the token is looked
+	// up in GitHubTokenSources, in order. From the environment these are
+	// GITHUB_TOKEN, GITHUB_TOKEN_FILE and the gh CLI config, followed by the
+	// git credential helper when GITHUB_USE_GIT_CREDENTIAL is set.
+	GitHubToken        string
+	GitHubTokenSources []credentials.Source
+	// GitHubURL is the GraphQL endpoint of a GitHub Enterprise Server, either
+	// the full endpoint (https://ghe.example.com/api/graphql) or just the
+	// instance URL. Empty means github.com.
+	GitHubURL string
+	// GitHubCABundle is the path to a PEM file with extra root certificates.
+	GitHubCABundle string
+	// GitHubProxy overrides the proxy otherwise taken from HTTPS_PROXY etc.
+	GitHubProxy string
+	// GitHubAPIURL is the REST API root, used for REST-only features and
+	// GitHub App token exchange. Empty means api.github.com, or /api/v3 of GitHubURL.
+	GitHubAPIURL string
+	// GitHubUsername overrides the login taken from the authenticated
+	// viewer. It is required with GitHub App authentication.
+	GitHubUsername string
+
+	// GitHub App installation authentication, used instead of GitHubToken
+	// when GitHubAppID is set. The private key is given either inline as PEM
+	// or as a path to a PEM file.
+	GitHubAppID             string
+	GitHubAppInstallationID string
+	GitHubAppPrivateKey     string
+	GitHubAppPrivateKeyFile string
+
+	// GitHubRules filters the repositories appearing in the results of every
+	// forge; the GITHUB_* variable names predate the other forges.
+	GitHubRules forgesvc.Rules
+	// GitHubTrafficHistory is the path of a JSON file accumulating repository
+	// traffic across runs. Empty disables the history.
+	GitHubTrafficHistory string
+	// GitHubPrivacy is one of include, redact or exclude (default) and, like
+	// GitHubRules, applies to every forge.
+	GitHubPrivacy string
+	// GitHubPrivateAliases maps "owner/name" of private repositories to the
+	// name shown in redact mode.
+	GitHubPrivateAliases map[string]string
+
+	// GitLabURL is the URL of a self-managed GitLab instance; empty means
+	// gitlab.com. GitLab functions are available once GitLabToken or
+	// GitLabUsername is set; merge requests, issues and groups require
+	// GitLabToken.
+	GitLabURL      string
+	GitLabToken    string
+	GitLabUsername string
+
+	// ForgejoURL is the URL of a Forgejo or Gitea instance; empty means
+	// Codeberg. Forgejo functions are available once ForgejoToken or
+	// ForgejoUsername is set.
+	ForgejoURL      string
+	ForgejoToken    string
+	ForgejoUsername string
+
+	// BitbucketURL is the Bitbucket Cloud API root; empty means
+	// api.bitbucket.org. Requests authenticate with BitbucketToken, or with
+	// BitbucketUsername and BitbucketAppPassword. BitbucketWorkspace selects
+	// the workspace repositories are listed from and defaults to the user's
+	// own. Bitbucket functions are available once a token or username is set.
+	BitbucketURL         string
+	BitbucketToken       string
+	BitbucketUsername    string
+	BitbucketAppPassword string
+	BitbucketWorkspace   string
+
+	// SourcehutGitURL and SourcehutTodoURL are the git.sr.ht and todo.sr.ht
+	// services of a sourcehut instance; empty means sr.ht. The sourcehut
+	// APIs reject anonymous requests, so SourcehutToken is required;
+	// SourcehutUsername defaults to the token's owner.
+	SourcehutGitURL   string
+	SourcehutTodoURL  string
+	SourcehutToken    string
+	SourcehutUsername string
+
+	GoodReadsToken string
+	GoodReadsID    string
+}
+
+// ConfigFromEnv reads a Config from environment variables.
+func ConfigFromEnv() Config {
+	return Config{
+		GitHubTokenSources: githubTokenSources(),
+		GitHubURL:          os.Getenv("GITHUB_GRAPHQL_URL"),
+		GitHubCABundle:     os.Getenv("GITHUB_CA_BUNDLE"),
+		GitHubProxy:        os.Getenv("GITHUB_PROXY"),
+		GitHubAPIURL:       os.Getenv("GITHUB_API_URL"),
+		GitHubUsername:     os.Getenv("GITHUB_USERNAME"),
+
+		GitHubAppID:             os.Getenv("GITHUB_APP_ID"),
+		GitHubAppInstallationID: os.Getenv("GITHUB_APP_INSTALLATION_ID"),
+		GitHubAppPrivateKey:     os.Getenv("GITHUB_APP_PRIVATE_KEY"),
+		GitHubAppPrivateKeyFile: os.Getenv("GITHUB_APP_PRIVATE_KEY_FILE"),
+
+		GitHubRules: forgesvc.Rules{
+			Include:         envList("GITHUB_INCLUDE_REPOS"),
+			Exclude:         envList("GITHUB_EXCLUDE_REPOS"),
+			ExcludeTopics:   envList("GITHUB_EXCLUDE_TOPICS"),
+			ExcludeArchived: envBool("GITHUB_EXCLUDE_ARCHIVED"),
+			ExcludeForks:    envBool("GITHUB_EXCLUDE_FORKS"),
+			AllowOwners:     envList("GITHUB_ALLOW_OWNERS"),
+			DenyOwners:      envList("GITHUB_DENY_OWNERS"),
+		},
+		GitHubTrafficHistory: os.Getenv("GITHUB_TRAFFIC_HISTORY"),
+		GitHubPrivacy:        os.Getenv("GITHUB_PRIVACY"),
+		GitHubPrivateAliases: envMap("GITHUB_PRIVATE_ALIASES"),
+
+		GitLabURL:      os.Getenv("GITLAB_URL"),
+		GitLabToken:    os.Getenv("GITLAB_TOKEN"),
+		GitLabUsername: os.Getenv("GITLAB_USERNAME"),
+
+		ForgejoURL:      os.Getenv("FORGEJO_URL"),
+		ForgejoToken:    os.Getenv("FORGEJO_TOKEN"),
+		ForgejoUsername: os.Getenv("FORGEJO_USERNAME"),
+
+		BitbucketURL:         os.Getenv("BITBUCKET_URL"),
+		BitbucketToken:       os.Getenv("BITBUCKET_TOKEN"),
+		BitbucketUsername:    os.Getenv("BITBUCKET_USERNAME"),
+		BitbucketAppPassword: os.Getenv("BITBUCKET_APP_PASSWORD"),
+		BitbucketWorkspace:   os.Getenv("BITBUCKET_WORKSPACE"),
+
+		SourcehutGitURL:   os.Getenv("SRHT_GIT_URL"),
+		SourcehutTodoURL:  os.Getenv("SRHT_TODO_URL"),
+		SourcehutToken:    os.Getenv("SRHT_TOKEN"),
+		SourcehutUsername: os.Getenv("SRHT_USERNAME"),
+
+		GoodReadsToken: os.Getenv("GOODREADS_TOKEN"),
+		GoodReadsID:    os.Getenv("GOODREADS_USER_ID"),
+	}
+}
+
+// envList reads a comma-separated list from the named environment variable.
+func envList(name string) []string {
+	var out []string
+	for _, v := range strings.Split(os.Getenv(name), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// envMap reads comma-separated key=value pairs from the named environment variable.
+func envMap(name string) map[string]string {
+	out := map[string]string{}
+	for _, pair := range envList(name) {
+		if k, v, ok := strings.Cut(pair, "="); ok {
+			out[strings.TrimSpace(k)] = strings.TrimSpace(v)
+		}
+	}
+	return out
+}
+
+// githubTokenSources returns the places a GitHub token is looked up in. The
+// git credential helper runs an external program, so it is only consulted when
+// GITHUB_USE_GIT_CREDENTIAL is set.
+func githubTokenSources() []credentials.Source {
+	sources := []credentials.Source{
+		credentials.Env("GITHUB_TOKEN"),
+		credentials.File(os.Getenv("GITHUB_TOKEN_FILE")),
+		credentials.GHConfig(),
+	}
+	if envBool("GITHUB_USE_GIT_CREDENTIAL") {
+		sources = append(sources, credentials.GitCredential())
+	}
+	return sources
+}
+
+// envBool reports whether the named environment variable is set to a true value.
+func envBool(name string) bool {
+	v, _ := strconv.ParseBool(os.Getenv(name))
+	return v
+}
+
 // NewFromEnv wires all dependencies based on environment variables and returns a ready-to-use Service.
 // This consolidates startup logic so callers (like cmd/markscribe) can remain lean.
 func NewFromEnv(ctx context.Context) (*Service, error) {
-	// Tokens and settings
-	gitHubToken := os.Getenv("GITHUB_TOKEN")
-	goodReadsToken := os.Getenv("GOODREADS_TOKEN")
-	goodReadsID := os.Getenv("GOODREADS_USER_ID")
+	return NewFromConfig(ctx, ConfigFromEnv())
+}
+
+// NewFromConfig wires all dependencies based on cfg and returns a ready-to-use Service.
+func NewFromConfig(ctx context.Context, cfg Config) (*Service, error) {
+	privacy, err := forgesvc.ParsePrivacy(cfg.GitHubPrivacy)
+	if err != nil {
+		return nil, err
+	}
+
+	// Base HTTP client for GitHub, honouring CA bundle and proxy settings
+	baseClient, err := httpclient.New(httpclient.Options{
+		CABundle: cfg.GitHubCABundle,
+		Proxy:    cfg.GitHubProxy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("can't configure GitHub HTTP client: %w", err)
+	}
 
 	// Optional authenticated HTTP client for GitHub
-	var httpClient *http.Client
-	if len(gitHubToken) > 0 {
-		httpClient = oauth2.NewClient(ctx, oauth2.StaticTokenSource(
-			&oauth2.Token{AccessToken: gitHubToken},
-		))
+	tokenSource, err := githubTokenSource(ctx, cfg, baseClient)
+	if err != nil {
+		return nil, err
+	}
+	httpClient := baseClient
+	if tokenSource != nil {
+		httpClient = oauth2.NewClient(context.WithValue(ctx, oauth2.HTTPClient, baseClient), tokenSource)
 	}
 
 	// External clients
-	ghClient := githubv4.NewClient(httpClient)
-	grClient := kbgoodreads.NewClient(goodReadsToken)
+	var ghClient *githubv4.Client
+	if cfg.GitHubURL != "" {
+		endpoint, err := graphQLEndpoint(cfg.GitHubURL)
+		if err != nil {
+			return nil, err
+		}
+		ghClient = githubv4.NewEnterpriseClient(endpoint, httpClient)
+	} else {
+		ghClient = githubv4.NewClient(httpClient)
+	}
+	grClient := kbgoodreads.NewClient(cfg.GoodReadsToken)
 
 	// Adapters
-	ghPort := githubadapter.New(ghClient)
-	grPort := goodreadsadapter.New(grClient, goodReadsID)
+	apiURL, err := restAPIURL(cfg)
+	if err != nil {
+		return nil, err
+	}
+	ghAdapterOpts := []githubadapter.Option{githubadapter.WithREST(httpClient, apiURL)}
+	if privacy == forgesvc.PrivacyExclude {
+		ghAdapterOpts = append(ghAdapterOpts, githubadapter.WithPublicOnly())
+	}
+	ghPort := githubadapter.New(ghClient, ghAdapterOpts...)
+	grPort := goodreadsadapter.New(grClient, cfg.GoodReadsID)
 	litPort := literaladapter.New()
 	rssPort := rssadapter.New()
 
-	// Username is only available with a token; non-fatal if missing
-	username := ""
-	if len(gitHubToken) > 0 {
-		var err error
+	// Username is only available with a user token; non-fatal if missing.
+	// App installations have no viewer, so the username must be configured.
+	username := cfg.GitHubUsername
+	switch {
+	case username != "":
+	case cfg.GitHubAppID != "":
+		return nil, fmt.Errorf("GITHUB_USERNAME is required with GitHub App authentication")
+	case tokenSource != nil:
 		username, err = ghPort.ViewerLogin(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("can't retrieve GitHub profile: %w", err)
@@ -75,30 +312,361 @@ func NewFromEnv(ctx context.Context) (*Service, error) {
 	}
 
 	// Services
-	ghSvc := githubsvc.New(ghPort, username)
+	filterOpts := []forgesvc.FilterOption{
+		forgesvc.WithRules(cfg.GitHubRules),
+		forgesvc.WithPrivacy(privacy, cfg.GitHubPrivateAliases),
+	}
+	ghOpts := []githubsvc.Option{githubsvc.WithFilter(filterOpts...)}
+	if cfg.GitHubTrafficHistory != "" {
+		ghOpts = append(ghOpts, githubsvc.WithTrafficHistory(trafficstoreadapter.New(cfg.GitHubTrafficHistory)))
+	}
+	ghSvc := githubsvc.New(ghPort, username, ghOpts...)
 	grSvc := goodreadssvc.New(grPort)
 	litSvc := literalsvc.New(litPort)
 	rssSvc := rsssvc.New(rssPort)
 
-	return New(ghSvc, grSvc, litSvc, rssSvc), nil
+	svc := New(ghSvc, grSvc, litSvc, rssSvc)
+	if cfg.GitLabToken != "" || cfg.GitLabUsername != "" {
+		svc.gl, err = newGitlabService(ctx, cfg, filterOpts...)
+		if err != nil {
+			return nil, err
+		}
+		svc.glAuthenticated = cfg.GitLabToken != ""
+	}
+	if cfg.ForgejoToken != "" || cfg.ForgejoUsername != "" {
+		svc.fj, err = newForgejoService(ctx, cfg, filterOpts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cfg.BitbucketToken != "" || cfg.BitbucketUsername != "" {
+		svc.bb, err = newBitbucketService(ctx, cfg, filterOpts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cfg.SourcehutToken != "" || cfg.SourcehutUsername != "" {
+		svc.sh, err = newSourcehutService(ctx, cfg, filterOpts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return svc, nil
+}
+
+// newGitlabService wires the GitLab service. Without a configured username,
+// the login of the token's owner is used.
+func newGitlabService(ctx context.Context, cfg Config, opts ...forgesvc.FilterOption) (*gitlabsvc.Service, error) {
+	httpClient := http.DefaultClient
+	if cfg.GitLabToken != "" {
+		httpClient = oauth2.NewClient(ctx, oauth2.StaticTokenSource(&oauth2.Token{AccessToken: cfg.GitLabToken}))
+	}
+	glPort := gitlabadapter.New(httpClient, cfg.GitLabURL)
+
+	username := cfg.GitLabUsername
+	if username == "" {
+		var err error
+		username, err = glPort.ViewerLogin(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("can't retrieve GitLab profile: %w", err)
+		}
+	}
+	return gitlabsvc.New(glPort, username, opts...), nil
+}
+
+// newForgejoService wires the Forgejo service. Without a configured username,
+// the login of the token's owner is used.
+func newForgejoService(ctx context.Context, cfg Config, opts ...forgesvc.FilterOption) (*forgejosvc.Service, error) {
+	httpClient := http.DefaultClient
+	if cfg.ForgejoToken != "" {
+		// Forgejo and Gitea expect "Authorization: token <value>".
+		httpClient = oauth2.NewClient(ctx, oauth2.StaticTokenSource(&oauth2.Token{AccessToken: cfg.ForgejoToken, TokenType: "token"}))
+	}
+	fjPort := forgejoadapter.New(httpClient, cfg.ForgejoURL)
+
+	username := cfg.ForgejoUsername
+	if username == "" {
+		var err error
+		username, err = fjPort.ViewerLogin(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("can't retrieve Forgejo profile: %w", err)
+		}
+	}
+	return forgejosvc.New(fjPort, username, opts...), nil
+}
+
+// newBitbucketService wires the Bitbucket service. Without a configured
+// username, the username of the token's owner is used.
+func newBitbucketService(ctx context.Context, cfg Config, opts ...forgesvc.FilterOption) (*bitbucketsvc.Service, error) {
+	if cfg.BitbucketAppPassword != "" && cfg.BitbucketUsername == "" {
+		return nil, fmt.Errorf("BITBUCKET_APP_PASSWORD requires BITBUCKET_USERNAME")
+	}
+	bbPort := bitbucketadapter.New(bitbucketClient(ctx, cfg), cfg.BitbucketURL)
+
+	username := cfg.BitbucketUsername
+	if username == "" {
+		var err error
+		username, err = bbPort.ViewerLogin(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("can't retrieve Bitbucket profile: %w", err)
+		}
+	}
+	return bitbucketsvc.New(bbPort, username, cfg.BitbucketWorkspace, opts...), nil
+}
+
+// newSourcehutService wires the sourcehut service. A token is required since
+// the sourcehut APIs reject anonymous requests. Without a configured
+// username, the username of the token's owner is used.
+func newSourcehutService(ctx context.Context, cfg Config, opts ...forgesvc.FilterOption) (*sourcehutsvc.Service, error) {
+	if cfg.SourcehutToken == "" {
+		return nil, fmt.Errorf("sourcehut requires SRHT_TOKEN")
+	}
+	httpClient := oauth2.NewClient(ctx, oauth2.StaticTokenSource(&oauth2.Token{AccessToken: cfg.SourcehutToken}))
+	shPort := sourcehutadapter.New(httpClient, cfg.SourcehutGitURL, cfg.SourcehutTodoURL)
+
+	username := cfg.SourcehutUsername
+	if username == "" {
+		var err error
+		username, err = shPort.ViewerLogin(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("can't retrieve sourcehut profile: %w", err)
+		}
+	}
+	return sourcehutsvc.New(shPort, username, opts...), nil
+}
+
+// graphQLEndpoint turns a GitHub Enterprise Server URL into its GraphQL
+// endpoint. A bare instance URL gets the default /api/graphql path.
+func graphQLEndpoint(raw string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return "", fmt.Errorf("invalid GitHub GraphQL URL %q", raw)
+	}
+	if strings.Trim(u.Path, "/") == "" {
+		u.Path = "/api/graphql"
+	}
+	return u.String(), nil
 }
 
 // GitHub
 func (s *Service) RecentRepos(count int) []domain.Repo { return s.gh.RecentRepos(count) }
 func (s *Service) RecentForks(count int) []domain.Repo { return s.gh.RecentForks(count) }
+func (s *Service) TopRepos(count int) []domain.Repo    { return s.gh.TopRepos(count) }
+func (s *Service) TrendingRepos(count int, window string) []domain.Repo {
+	return s.gh.TrendingRepos(count, window)
+}
 func (s *Service) Repo(owner, name string) domain.Repo { return s.gh.Repo(owner, name) }
-func (s *Service) Followers(count int) []domain.User   { return s.gh.Followers(count) }
-func (s *Service) RecentPullRequests(count int) []domain.PullRequest {
-	return s.gh.RecentPullRequests(count)
+func (s *Service) Releases(owner, name string, count int) []domain.Release {
+	return s.gh.Releases(owner, name, count)
+}
+func (s *Service) Contributors(owner, name string, count int) []domain.Contributor {
+	return s.gh.Contributors(owner, name, count)
+}
+func (s *Service) OpenIssues(owner, name string, count int, labels ...string) []domain.Issue {
+	return s.gh.OpenIssues(owner, name, count, labels...)
+}
+func (s *Service) LatestRelease(owner, name string) domain.Release {
+	return s.gh.LatestRelease(owner, name)
+}
+func (s *Service) Milestones(owner, name string, count int) []domain.Milestone {
+	return s.gh.Milestones(owner, name, count)
+}
+func (s *Service) Languages(owner, name string) []domain.Language {
+	return s.gh.Languages(owner, name)
+}
+func (s *Service) Profile() domain.Profile               { return s.gh.Profile() }
+func (s *Service) ProfileOf(login string) domain.Profile { return s.gh.ProfileOf(login) }
+func (s *Service) Following(count int) []domain.User     { return s.gh.Following(count) }
+func (s *Service) Mutuals(count int) []domain.User       { return s.gh.Mutuals(count) }
+func (s *Service) Followers(count int) []domain.User     { return s.gh.Followers(count) }
+func (s *Service) RecentPullRequests(count int, states ...string) []domain.PullRequest {
+	return s.gh.RecentPullRequests(count, states...)
+}
+func (s *Service) ExternalContributions(count int) []domain.ExternalContribution {
+	return s.gh.ExternalContributions(count)
 }
 func (s *Service) RecentReleases(count int) []domain.Repo { return s.gh.RecentReleases(count) }
 func (s *Service) RecentContributions(count int) []domain.Contribution {
 	return s.gh.RecentContributions(count)
 }
-func (s *Service) Gists(count int) []domain.Gist         { return s.gh.Gists(count) }
-func (s *Service) RecentStars(count int) []domain.Star   { return s.gh.RecentStars(count) }
-func (s *Service) RecentIssues(count int) []domain.Issue { return s.gh.RecentIssues(count) }
-func (s *Service) Sponsors(count int) []domain.Sponsor   { return s.gh.Sponsors(count) }
+func (s *Service) RecentCommits(count int, exclude ...string) []domain.Commit {
+	return s.gh.RecentCommits(count, exclude...)
+}
+func (s *Service) Gists(count int) []domain.Gist       { return s.gh.Gists(count) }
+func (s *Service) RecentStars(count int) []domain.Star { return s.gh.RecentStars(count) }
+func (s *Service) RecentIssues(count int, states ...string) []domain.Issue {
+	return s.gh.RecentIssues(count, states...)
+}
+func (s *Service) RecentReviews(count int) []domain.Review { return s.gh.RecentReviews(count) }
+func (s *Service) RecentDiscussions(count int) []domain.Discussion {
+	return s.gh.RecentDiscussions(count)
+}
+func (s *Service) RecentDiscussionComments(count int) []domain.Discussion {
+	return s.gh.RecentDiscussionComments(count)
+}
+func (s *Service) Sponsors(count int) []domain.Sponsor  { return s.gh.Sponsors(count) }
+func (s *Service) SponsorsGoal() domain.SponsorsGoal    { return s.gh.SponsorsGoal() }
+func (s *Service) Sponsoring(count int) []domain.User   { return s.gh.Sponsoring(count) }
+func (s *Service) PinnedRepos() []domain.Repo           { return s.gh.PinnedRepos() }
+func (s *Service) PinnedGists() []domain.Gist           { return s.gh.PinnedGists() }
+func (s *Service) Activity(count int) []domain.Activity { return s.gh.Activity(count) }
+func (s *Service) Traffic(repo string) domain.Traffic   { return s.gh.Traffic(repo) }
+func (s *Service) WorkflowRuns(repo string, count int) []domain.WorkflowRun {
+	return s.gh.WorkflowRuns(repo, count)
+}
+func (s *Service) WorkflowStatus(repo, workflow string) domain.WorkflowRun {
+	return s.gh.WorkflowStatus(repo, workflow)
+}
+
+// GitLab
+func (s *Service) gitlab() *gitlabsvc.Service {
+	if s.gl == nil {
+		panic("GitLab is not configured, set GITLAB_TOKEN or GITLAB_USERNAME")
+	}
+	return s.gl
+}
+
+// gitlabAuthenticated is gitlab for functions listing data of the token's
+// owner. GitLab answers those endpoints without a token too, but with public
+// data of the whole instance, so fn fails instead.
+func (s *Service) gitlabAuthenticated(fn string) *gitlabsvc.Service {
+	gl := s.gitlab()
+	if !s.glAuthenticated {
+		panic(fn + " requires GITLAB_TOKEN")
+	}
+	return gl
+}
+func (s *Service) GitlabRecentRepos(count int) []domain.Repo { return s.gitlab().RecentRepos(count) }
+func (s *Service) GitlabRecentForks(count int) []domain.Repo { return s.gitlab().RecentForks(count) }
+func (s *Service) GitlabRecentMergeRequests(count int) []domain.PullRequest {
+	return s.gitlabAuthenticated("gitlabRecentMergeRequests").RecentMergeRequests(count)
+}
+func (s *Service) GitlabRecentIssues(count int) []domain.Issue {
+	return s.gitlabAuthenticated("gitlabRecentIssues").RecentIssues(count)
+}
+func (s *Service) GitlabRecentReleases(count int) []domain.Repo {
+	return s.gitlab().RecentReleases(count)
+}
+func (s *Service) GitlabRecentStars(count int) []domain.Star { return s.gitlab().RecentStars(count) }
+func (s *Service) GitlabRecentContributions(count int) []domain.Contribution {
+	return s.gitlab().RecentContributions(count)
+}
+func (s *Service) GitlabGroups(count int) []domain.User {
+	return s.gitlabAuthenticated("gitlabGroups").Groups(count)
+}
+
+// Forgejo
+func (s *Service) forgejo() *forgejosvc.Service {
+	if s.fj == nil {
+		panic("Forgejo is not configured, set FORGEJO_TOKEN or FORGEJO_USERNAME")
+	}
+	return s.fj
+}
+func (s *Service) ForgejoRecentRepos(count int) []domain.Repo { return s.forgejo().RecentRepos(count) }
+func (s *Service) ForgejoRecentForks(count int) []domain.Repo { return s.forgejo().RecentForks(count) }
+func (s *Service) ForgejoRecentReleases(count int) []domain.Repo {
+	return s.forgejo().RecentReleases(count)
+}
+func (s *Service) ForgejoRecentStars(count int) []domain.Star { return s.forgejo().RecentStars(count) }
+func (s *Service) ForgejoContributions(days int) []domain.ContributionDay {
+	return s.forgejo().ContributionDays(days)
+}
+
+// Bitbucket
+func (s *Service) bitbucket() *bitbucketsvc.Service {
+	if s.bb == nil {
+		panic("Bitbucket is not configured, set BITBUCKET_TOKEN or BITBUCKET_USERNAME")
+	}
+	return s.bb
+}
+func (s *Service) BitbucketRecentRepos(count int) []domain.Repo {
+	return s.bitbucket().RecentRepos(count)
+}
+func (s *Service) BitbucketRecentForks(count int) []domain.Repo {
+	return s.bitbucket().RecentForks(count)
+}
+func (s *Service) BitbucketRecentPullRequests(count int) []domain.PullRequest {
+	return s.bitbucket().RecentPullRequests(count)
+}
+func (s *Service) BitbucketWorkspaces(count int) []domain.User {
+	return s.bitbucket().Workspaces(count)
+}
+
+// sourcehut
+func (s *Service) sourcehut() *sourcehutsvc.Service {
+	if s.sh == nil {
+		panic("sourcehut is not configured, set SRHT_TOKEN")
+	}
+	return s.sh
+}
+func (s *Service) SourcehutRecentRepos(count int) []domain.Repo {
+	return s.sourcehut().RecentRepos(count)
+}
+func (s *Service) SourcehutRecentTickets(count int) []domain.Issue {
+	return s.sourcehut().RecentTickets(count)
+}
+
+// Forge-neutral
+
+// forges merges the configured forges, in the order GitHub, GitLab,
+// Forgejo, Bitbucket, sourcehut, which is also their precedence for mirrors.
+// GitHub counts as configured once there is a user to list.
+func (s *Service) forges() *forgesvc.Service {
+	var sources []forgesvc.Source
+	if s.gh != nil && s.gh.Username() != "" {
+		sources = append(sources, forgesvc.Source{
+			Forge:    forgesvc.GitHub,
+			Repos:    s.gh.RecentRepos,
+			Forks:    s.gh.RecentForks,
+			Releases: s.gh.RecentReleases,
+			PullRequests: func(count int) []domain.PullRequest {
+				return s.gh.RecentPullRequests(count)
+			},
+		})
+	}
+	if s.gl != nil {
+		src := forgesvc.Source{
+			Forge:    forgesvc.GitLab,
+			Repos:    s.gl.RecentRepos,
+			Forks:    s.gl.RecentForks,
+			Releases: s.gl.RecentReleases,
+		}
+		if s.glAuthenticated {
+			src.PullRequests = s.gl.RecentMergeRequests
+		}
+		sources = append(sources, src)
+	}
+	if s.fj != nil {
+		sources = append(sources, forgesvc.Source{
+			Forge:    forgesvc.Forgejo,
+			Repos:    s.fj.RecentRepos,
+			Forks:    s.fj.RecentForks,
+			Releases: s.fj.RecentReleases,
+		})
+	}
+	if s.bb != nil {
+		sources = append(sources, forgesvc.Source{
+			Forge:        forgesvc.Bitbucket,
+			Repos:        s.bb.RecentRepos,
+			Forks:        s.bb.RecentForks,
+			PullRequests: s.bb.RecentPullRequests,
+		})
+	}
+	if s.sh != nil {
+		sources = append(sources, forgesvc.Source{
+			Forge: forgesvc.Sourcehut,
+			Repos: s.sh.RecentRepos,
+		})
+	}
+	return forgesvc.New(sources...)
+}
+func (s *Service) Repos(count int) []domain.Repo         { return s.forges().Repos(count) }
+func (s *Service) Forks(count int) []domain.Repo         { return s.forges().Forks(count) }
+func (s *Service) ReleasedRepos(count int) []domain.Repo { return s.forges().Releases(count) }
+func (s *Service) PullRequests(count int) []domain.PullRequest {
+	return s.forges().PullRequests(count)
+}
+func (s *Service) Forges() []string { return s.forges().Forges() }
 
 // GoodReads
 func (s *Service) GoodReadsReviews(count int) []responses.Review { return s.gr.Reviews(count) }
@@ -131,6 +699,28 @@ func (s *Service) Humanize(t interface{}) string {
 	}
 }
 
+// FirstSection returns the leading part of a Markdown document up to, but not
+// including, its second heading, e.g. to shorten release notes. Headings inside
+// fenced code blocks are ignored.
+func (s *Service) FirstSection(markdown string) string {
+	lines := strings.Split(markdown, "\n")
+	inFence := false
+	seenContent := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+		}
+		if !inFence && strings.HasPrefix(trimmed, "#") && seenContent {
+			return strings.TrimRight(strings.Join(lines[:i], "\n"), "\n\r ")
+		}
+		if trimmed != "" {
+			seenContent = true
+		}
+	}
+	return strings.TrimRight(markdown, "\n\r ")
+}
+
 func (s *Service) Reverse(slc interface{}) interface{} {
 	n := reflect.ValueOf(slc).Len()
 	swap := reflect.Swapper(slc)
@@ -144,17 +734,71 @@ func (s *Service) Reverse(slc interface{}) interface{} {
 func (s *Service) Funcs() texttmpl.FuncMap {
 	return texttmpl.FuncMap{
 		// GitHub
-		"recentContributions": s.RecentContributions,
-		"recentPullRequests":  s.RecentPullRequests,
-		"recentRepos":         s.RecentRepos,
-		"recentForks":         s.RecentForks,
-		"recentReleases":      s.RecentReleases,
-		"followers":           s.Followers,
-		"recentStars":         s.RecentStars,
-		"gists":               s.Gists,
-		"recentIssues":        s.RecentIssues,
-		"sponsors":            s.Sponsors,
-		"repo":                s.Repo,
+		"recentContributions":      s.RecentContributions,
+		"recentCommits":            s.RecentCommits,
+		"recentPullRequests":       s.RecentPullRequests,
+		"externalContributions":    s.ExternalContributions,
+		"recentRepos":              s.RecentRepos,
+		"recentForks":              s.RecentForks,
+		"topRepos":                 s.TopRepos,
+		"trendingRepos":            s.TrendingRepos,
+		"recentReleases":           s.RecentReleases,
+		"profile":                  s.Profile,
+		"profileOf":                s.ProfileOf,
+		"following":                s.Following,
+		"mutuals":                  s.Mutuals,
+		"followers":                s.Followers,
+		"recentStars":              s.RecentStars,
+		"gists":                    s.Gists,
+		"recentIssues":             s.RecentIssues,
+		"recentReviews":            s.RecentReviews,
+		"recentDiscussions":        s.RecentDiscussions,
+		"recentDiscussionComments": s.RecentDiscussionComments,
+		"sponsorsGoal":             s.SponsorsGoal,
+		"sponsoring":               s.Sponsoring,
+		"sponsors":                 s.Sponsors,
+		"repo":                     s.Repo,
+		"releases":                 s.Releases,
+		"contributors":             s.Contributors,
+		"openIssues":               s.OpenIssues,
+		"latestRelease":            s.LatestRelease,
+		"milestones":               s.Milestones,
+		"languages":                s.Languages,
+		"pinnedRepos":              s.PinnedRepos,
+		"pinnedGists":              s.PinnedGists,
+		"activity":                 s.Activity,
+		"traffic":                  s.Traffic,
+		"workflowRuns":             s.WorkflowRuns,
+		"workflowStatus":           s.WorkflowStatus,
+		// GitLab
+		"gitlabRecentRepos":         s.GitlabRecentRepos,
+		"gitlabRecentForks":         s.GitlabRecentForks,
+		"gitlabRecentMergeRequests": s.GitlabRecentMergeRequests,
+		"gitlabRecentIssues":        s.GitlabRecentIssues,
+		"gitlabRecentReleases":      s.GitlabRecentReleases,
+		"gitlabRecentStars":         s.GitlabRecentStars,
+		"gitlabRecentContributions": s.GitlabRecentContributions,
+		"gitlabGroups":              s.GitlabGroups,
+		// Forgejo / Gitea / Codeberg
+		"forgejoRecentRepos":    s.ForgejoRecentRepos,
+		"forgejoRecentForks":    s.ForgejoRecentForks,
+		"forgejoRecentReleases": s.ForgejoRecentReleases,
+		"forgejoRecentStars":    s.ForgejoRecentStars,
+		"forgejoContributions":  s.ForgejoContributions,
+		// Bitbucket Cloud
+		"bitbucketRecentRepos":        s.BitbucketRecentRepos,
+		"bitbucketRecentForks":        s.BitbucketRecentForks,
+		"bitbucketRecentPullRequests": s.BitbucketRecentPullRequests,
+		"bitbucketWorkspaces":         s.BitbucketWorkspaces,
+		// sourcehut
+		"sourcehutRecentRepos":   s.SourcehutRecentRepos,
+		"sourcehutRecentTickets": s.SourcehutRecentTickets,
+		// All configured forges
+		"repos":         s.Repos,
+		"forks":         s.Forks,
+		"releasedRepos": s.ReleasedRepos,
+		"pullRequests":  s.PullRequests,
+		"forges":        s.Forges,
 		// RSS
 		"rss": s.LatestRssFeeds,
 		// GoodReads
@@ -163,10 +807,11 @@ func (s *Service) Funcs() texttmpl.FuncMap {
 		// Literal.club
 		"literalClubCurrentlyReading": s.LiteralCurrentlyReading,
 		// Utils
-		"humanize": s.Humanize,
-		"reverse":  s.Reverse,
-		"now":      time.Now,
-		"contains": strings.Contains,
-		"toLower":  strings.ToLower,
+		"humanize":     s.Humanize,
+		"firstSection": s.FirstSection,
+		"reverse":      s.Reverse,
+		"now":          time.Now,
+		"contains":     strings.Contains,
+		"toLower":      strings.ToLower,
 	}
 }
@@ -0,0 +1,269 @@
+package template
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	domain "hufschlaeger.net/markscribe/internal/domain"
+	"hufschlaeger.net/markscribe/internal/infra/credentials"
+	forgejosvc "hufschlaeger.net/markscribe/internal/service/forgejo"
+	githubsvc "hufschlaeger.net/markscribe/internal/service/github"
+	gitlabsvc "hufschlaeger.net/markscribe/internal/service/gitlab"
+)
+
+func TestService_FirstSection(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		expected string
+	}{
+		{
+			name:     "stops before second heading",
+			markdown: "## Features\n\n- faster\n\n## Fixes\n\n- crash\n",
+			expected: "## Features\n\n- faster",
+		},
+		{
+			name:     "text before the first heading is the first section",
+			markdown: "Small bugfix release.\n\n## Changelog\n- abc\n",
+			expected: "Small bugfix release.",
+		},
+		{
+			name:     "ignores headings in code fences",
+			markdown: "## Usage\n```sh\n# install\ngo install\n```\n## Other\n",
+			expected: "## Usage\n```sh\n# install\ngo install\n```",
+		},
+		{
+			name:     "returns whole document without further headings",
+			markdown: "Just notes.\n",
+			expected: "Just notes.",
+		},
+		{
+			name:     "empty",
+			markdown: "",
+			expected: "",
+		},
+	}
+
+	s := &Service{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, s.FirstSection(tt.markdown))
+		})
+	}
+}
+
+func TestGraphQLEndpoint(t *testing.T) {
+	tests := []struct {
+		name      string
+		raw       string
+		expected  string
+		expectErr bool
+	}{
+		{name: "instance URL", raw: "https://ghe.example.com", expected: "https://ghe.example.com/api/graphql"},
+		{name: "instance URL with slash", raw: "https://ghe.example.com/", expected: "https://ghe.example.com/api/graphql"},
+		{name: "full endpoint", raw: "https://ghe.example.com/api/graphql", expected: "https://ghe.example.com/api/graphql"},
+		{name: "missing scheme", raw: "ghe.example.com", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := graphQLEndpoint(tt.raw)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestRestAPIURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      Config
+		expected string
+	}{
+		{name: "github.com", cfg: Config{}, expected: "https://api.github.com"},
+		{name: "enterprise server", cfg: Config{GitHubURL: "https://ghe.example.com/api/graphql"}, expected: "https://ghe.example.com/api/v3"},
+		{name: "explicit", cfg: Config{GitHubURL: "https://ghe.example.com", GitHubAPIURL: "https://api.ghe.example.com"}, expected: "https://api.ghe.example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := restAPIURL(tt.cfg)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestNewFromConfig_GitHubAppRequiresUsername(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	_, err = NewFromConfig(context.Background(), Config{
+		GitHubAppID:             "1",
+		GitHubAppInstallationID: "2",
+		GitHubAppPrivateKey:     string(keyPEM),
+	})
+	assert.ErrorContains(t, err, "GITHUB_USERNAME")
+}
+
+func TestBitbucketClient_AppPassword(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "me", user)
+		assert.Equal(t, "secret", pass)
+	}))
+	defer srv.Close()
+
+	client := bitbucketClient(context.Background(), Config{BitbucketUsername: "me", BitbucketAppPassword: "secret"})
+	resp, err := client.Get(srv.URL)
+	assert.NoError(t, err)
+	resp.Body.Close()
+
+	_, err = newBitbucketService(context.Background(), Config{BitbucketAppPassword: "secret"})
+	assert.ErrorContains(t, err, "BITBUCKET_USERNAME")
+}
+
+func TestNewSourcehutService_RequiresToken(t *testing.T) {
+	_, err := newSourcehutService(context.Background(), Config{SourcehutUsername: "~me"})
+	assert.ErrorContains(t, err, "SRHT_TOKEN")
+}
+
+func TestService_GitlabRequiresToken(t *testing.T) {
+	svc := &Service{gl: gitlabsvc.New(nil, "me")}
+
+	assert.PanicsWithValue(t, "gitlabRecentMergeRequests requires GITLAB_TOKEN", func() { svc.GitlabRecentMergeRequests(1) })
+	assert.PanicsWithValue(t, "gitlabRecentIssues requires GITLAB_TOKEN", func() { svc.GitlabRecentIssues(1) })
+	assert.PanicsWithValue(t, "gitlabGroups requires GITLAB_TOKEN", func() { svc.GitlabGroups(1) })
+}
+
+// fakeForgejo serves a fixed list of repositories.
+type fakeForgejo struct {
+	repos []domain.Repo
+}
+
+func (f fakeForgejo) ViewerLogin(context.Context) (string, error) { return "me", nil }
+func (f fakeForgejo) RecentRepos(_ context.Context, _ string, count int, isFork bool, _ func(domain.Repo) bool) ([]domain.Repo, error) {
+	if isFork {
+		return nil, nil
+	}
+	return f.repos[:min(count, len(f.repos))], nil
+}
+func (f fakeForgejo) RecentReleases(context.Context, string, int, func(domain.Repo) bool) ([]domain.Repo, error) {
+	return nil, nil
+}
+func (f fakeForgejo) RecentStars(context.Context, string, int, func(domain.Repo) bool) ([]domain.Star, error) {
+	return nil, nil
+}
+func (f fakeForgejo) ContributionDays(context.Context, string, time.Time) ([]domain.ContributionDay, error) {
+	return nil, nil
+}
+
+func TestService_ForgesWithoutGitHub(t *testing.T) {
+	// No GitHub credentials or username: the GitHub service has no user and
+	// its port must not be called.
+	svc := &Service{
+		gh: githubsvc.New(nil, ""),
+		fj: forgejosvc.New(fakeForgejo{repos: []domain.Repo{{Name: "me/app"}}}, "me"),
+	}
+
+	assert.Equal(t, []string{"forgejo"}, svc.Forges())
+	assert.Equal(t, []domain.Repo{{Name: "me/app", Forge: "forgejo"}}, svc.Repos(5))
+	assert.Empty(t, svc.Forks(5))
+	assert.Empty(t, svc.ReleasedRepos(5))
+	assert.Empty(t, svc.PullRequests(5))
+}
+
+func TestGithubTokenSource(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	assert.NoError(t, os.WriteFile(tokenFile, []byte("from-file\n"), 0o600))
+	t.Setenv("MARKSCRIBE_TEST_TOKEN", "")
+
+	tests := []struct {
+		name     string
+		cfg      Config
+		expected string
+	}{
+		{
+			name: "explicit token wins",
+			cfg: Config{
+				GitHubToken:        "explicit",
+				GitHubTokenSources: []credentials.Source{credentials.File(tokenFile)},
+			},
+			expected: "explicit",
+		},
+		{
+			name: "falls through empty sources",
+			cfg: Config{
+				GitHubTokenSources: []credentials.Source{credentials.Env("MARKSCRIBE_TEST_TOKEN"), credentials.File(tokenFile)},
+			},
+			expected: "from-file",
+		},
+		{
+			name: "no credentials",
+			cfg:  Config{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts, err := githubTokenSource(context.Background(), tt.cfg, nil)
+			assert.NoError(t, err)
+			if tt.expected == "" {
+				assert.Nil(t, ts)
+				return
+			}
+			tok, err := ts.Token()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, tok.AccessToken)
+		})
+	}
+}
+
+func TestConfigFromEnv_Filters(t *testing.T) {
+	t.Setenv("GITHUB_EXCLUDE_REPOS", "our-org/internal-*, me/test ,")
+	t.Setenv("GITHUB_EXCLUDE_ARCHIVED", "true")
+	t.Setenv("GITHUB_DENY_OWNERS", "test-org")
+	t.Setenv("GITHUB_PRIVATE_ALIASES", "corp/billing=Billing service, corp/sso = SSO")
+
+	cfg := ConfigFromEnv()
+	rules := cfg.GitHubRules
+
+	assert.Equal(t, []string{"our-org/internal-*", "me/test"}, rules.Exclude)
+	assert.True(t, rules.ExcludeArchived)
+	assert.False(t, rules.ExcludeForks)
+	assert.Equal(t, []string{"test-org"}, rules.DenyOwners)
+	assert.Nil(t, rules.Include)
+	assert.Equal(t, map[string]string{"corp/billing": "Billing service", "corp/sso": "SSO"}, cfg.GitHubPrivateAliases)
+}
+
+func TestGithubTokenSources_GitCredentialOptIn(t *testing.T) {
+	names := func() []string {
+		var out []string
+		for _, src := range githubTokenSources() {
+			out = append(out, src.Name())
+		}
+		return out
+	}
+
+	t.Setenv("GITHUB_USE_GIT_CREDENTIAL", "")
+	assert.NotContains(t, names(), "git credential helper")
+
+	t.Setenv("GITHUB_USE_GIT_CREDENTIAL", "1")
+	assert.Contains(t, names(), "git credential helper")
+}
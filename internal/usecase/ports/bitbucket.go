@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+// BitbucketPort defines the Bitbucket Cloud operations used by the
+// application. Workspaces are mapped onto domain.User. Listings skip items in
+// repositories rejected by keep, so count only counts kept items.
+type BitbucketPort interface {
+	ViewerLogin(ctx context.Context) (string, error)
+	RecentRepos(ctx context.Context, workspace string, count int, isFork bool, keep func(domain.Repo) bool) ([]domain.Repo, error)
+	RecentPullRequests(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.PullRequest, error)
+	// Workspaces returns the workspaces of the authenticated user.
+	Workspaces(ctx context.Context, count int) ([]domain.User, error)
+}
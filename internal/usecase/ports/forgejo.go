@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+// ForgejoPort defines the Forgejo/Gitea operations used by the application,
+// e.g. against Codeberg. Listings skip repositories rejected by keep, so
+// count only counts kept items.
+type ForgejoPort interface {
+	ViewerLogin(ctx context.Context) (string, error)
+	RecentRepos(ctx context.Context, username string, count int, isFork bool, keep func(domain.Repo) bool) ([]domain.Repo, error)
+	RecentReleases(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Repo, error)
+	RecentStars(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Star, error)
+	// ContributionDays returns the user's contributions per day since the
+	// given time, for days with at least one contribution.
+	ContributionDays(ctx context.Context, username string, since time.Time) ([]domain.ContributionDay, error)
+}
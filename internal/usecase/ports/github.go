@@ -2,22 +2,51 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	domain "hufschlaeger.net/markscribe/internal/domain"
 )
 
 // GithubPort defines the minimal set of GitHub operations used by the application.
 // This is intentionally small for the first incremental extraction.
+//
+// Listings taking a keep function skip items whose repository it rejects while
+// paging, so they return up to count kept items; a nil keep keeps everything.
 type GithubPort interface {
-	RecentRepos(ctx context.Context, username string, count int, isFork bool) ([]domain.Repo, error)
+	RecentRepos(ctx context.Context, username string, count int, isFork bool, keep func(domain.Repo) bool) ([]domain.Repo, error)
+	TopRepos(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Repo, error)
+	TrendingRepos(ctx context.Context, username string, since time.Time) ([]domain.Repo, error)
 	Repo(ctx context.Context, owner, name string) (domain.Repo, error)
+	Releases(ctx context.Context, owner, name string, count int) ([]domain.Release, error)
+	Contributors(ctx context.Context, owner, name string) ([]domain.Contributor, error)
+	OpenIssues(ctx context.Context, owner, name string, count int, labels []string) ([]domain.Issue, error)
+	LatestRelease(ctx context.Context, owner, name string) (domain.Release, error)
+	Milestones(ctx context.Context, owner, name string, count int) ([]domain.Milestone, error)
+	Languages(ctx context.Context, owner, name string) ([]domain.Language, error)
 	ViewerLogin(ctx context.Context) (string, error)
+	Profile(ctx context.Context, login string) (domain.Profile, error)
 	Followers(ctx context.Context, username string, count int) ([]domain.User, error)
-	RecentPullRequests(ctx context.Context, username string, count int) ([]domain.PullRequest, error)
-	RecentReleases(ctx context.Context, username string, count int) ([]domain.Repo, error)
-	RecentContributions(ctx context.Context, username string, count int) ([]domain.Contribution, error)
+	Following(ctx context.Context, username string, count int) ([]domain.User, error)
+	Organizations(ctx context.Context, username string, count int) ([]domain.User, error)
+	RecentPullRequests(ctx context.Context, username string, count int, states []string, keep func(domain.Repo) bool) ([]domain.PullRequest, error)
+	RecentReleases(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Repo, error)
+	RecentContributions(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Contribution, error)
+	RecentCommits(ctx context.Context, username string, count int, keep func(domain.Commit) bool) ([]domain.Commit, error)
 	Gists(ctx context.Context, username string, count int) ([]domain.Gist, error)
-	RecentStars(ctx context.Context, username string, count int) ([]domain.Star, error)
-	RecentIssues(ctx context.Context, username string, count int) ([]domain.Issue, error)
+	RecentStars(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Star, error)
+	RecentIssues(ctx context.Context, username string, count int, states []string, keep func(domain.Repo) bool) ([]domain.Issue, error)
+	RecentReviews(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Review, error)
+	RecentDiscussions(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Discussion, error)
+	RecentDiscussionComments(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Discussion, error)
 	Sponsors(ctx context.Context, username string, count int) ([]domain.Sponsor, error)
+	SponsorsGoal(ctx context.Context, username string) (domain.SponsorsGoal, error)
+	Sponsoring(ctx context.Context, username string, count int) ([]domain.User, error)
+	PinnedRepos(ctx context.Context, username string) ([]domain.Repo, error)
+	PinnedGists(ctx context.Context, username string) ([]domain.Gist, error)
+	Activity(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Activity, error)
+	// Traffic requires push access to the repository.
+	Traffic(ctx context.Context, owner, name string) (domain.Traffic, error)
+	WorkflowRuns(ctx context.Context, owner, name string, count int) ([]domain.WorkflowRun, error)
+	// LatestWorkflowRun returns the zero value if the workflow has not run yet.
+	LatestWorkflowRun(ctx context.Context, owner, name, workflow string) (domain.WorkflowRun, error)
 }
@@ -0,0 +1,23 @@
+package ports
+
+import (
+	"context"
+
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+// GitlabPort defines the GitLab operations used by the application. Projects
+// are mapped onto domain.Repo, merge requests onto domain.PullRequest and
+// groups onto domain.User. Listings skip items in projects rejected by keep,
+// so count only counts kept items.
+type GitlabPort interface {
+	ViewerLogin(ctx context.Context) (string, error)
+	RecentProjects(ctx context.Context, username string, count int, isFork bool, keep func(domain.Repo) bool) ([]domain.Repo, error)
+	RecentMergeRequests(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.PullRequest, error)
+	RecentIssues(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Issue, error)
+	RecentReleases(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Repo, error)
+	RecentStars(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Star, error)
+	RecentContributions(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Contribution, error)
+	// Groups returns the groups of the authenticated user.
+	Groups(ctx context.Context, count int) ([]domain.User, error)
+}
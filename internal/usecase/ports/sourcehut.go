@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+// SourcehutPort defines the sourcehut operations used by the application:
+// git.sr.ht repositories and todo.sr.ht tickets, the latter mapped onto
+// domain.Issue. Listings skip items in repositories or trackers rejected by
+// keep, so count only counts kept items.
+type SourcehutPort interface {
+	ViewerLogin(ctx context.Context) (string, error)
+	RecentRepos(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Repo, error)
+	RecentTickets(ctx context.Context, username string, count int, keep func(domain.Repo) bool) ([]domain.Issue, error)
+}
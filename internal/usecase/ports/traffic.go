@@ -0,0 +1,14 @@
+package ports
+
+import (
+	domain "hufschlaeger.net/markscribe/internal/domain"
+)
+
+// TrafficHistoryPort persists daily repository traffic across runs, so totals
+// can cover more than the 14 days GitHub keeps.
+type TrafficHistoryPort interface {
+	// Load returns the stored days of repo ("owner/name"), oldest first.
+	Load(repo string) ([]domain.TrafficDay, error)
+	// Save replaces the stored days of repo.
+	Save(repo string, days []domain.TrafficDay) error
+}